@@ -29,36 +29,58 @@ import (
 
 	"github.com/karmada-io/dashboard/cmd/api/app/options"
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/aggregate"                // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/assistant"                // Importing route packages forces route registration
 	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"                       // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cluster"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusteroverridepolicy"    // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterpropagationpolicy" // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterregistration"      // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterresourcebinding"   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/config"                   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/configmap"                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cronfederatedhpa"         // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cronjob"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/daemonset"                // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/deployment"               // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/drift"                    // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/event"                    // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/federatedhpa"             // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/ingress"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/job"                      // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/logs"                     // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/member"                   // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/migration"                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/multiclusteringress"      // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/multiclusterservice"      // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/namespace"                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/operation"                // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overridepolicy"           // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overview"                 // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/promote"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/propagationpolicy"        // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/resourcebinding"          // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/scheduling"               // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/secret"                   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/service"                  // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/serviceexport"            // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/serviceimport"            // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/statefulset"              // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/terminal"                 // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/topology"                 // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/unstructured"             // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/work"                     // Importing route packages forces route registration
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/clusterhistory"
 	"github.com/karmada-io/dashboard/pkg/config"
+	"github.com/karmada-io/dashboard/pkg/drift"
 	"github.com/karmada-io/dashboard/pkg/environment"
 	"github.com/karmada-io/dashboard/pkg/informer"
 	"github.com/karmada-io/dashboard/pkg/llm"
 	"github.com/karmada-io/dashboard/pkg/mcpclient"
 	oidcpkg "github.com/karmada-io/dashboard/pkg/oidc"
+	"github.com/karmada-io/dashboard/pkg/policyrevision"
+	"github.com/karmada-io/dashboard/pkg/templaterevision"
 )
 
 // NewAPICommand creates a *cobra.Command object with default parameters
@@ -185,6 +207,17 @@ func run(ctx context.Context, opts *options.Options) error {
 		}
 	}
 
+	// Policy revisions are kept in the dashboard namespace of the host cluster
+	policyrevision.Init(client.InClusterClient(), opts.Namespace)
+	templaterevision.Init(client.InClusterClient(), opts.Namespace)
+	// Cluster condition history is kept there as well, recorded from the shared cluster informer
+	clusterhistory.Init(client.InClusterClient(), opts.Namespace)
+
+	// Drift of member cluster objects is scanned on a schedule when enabled
+	if opts.DriftScanInterval > 0 {
+		go drift.NewScanner(client.InClusterKarmadaClient(), opts.DriftScanNamespaces, opts.DriftScanInterval).Run(ctx)
+	}
+
 	serve(opts, mcpClient)
 	config.InitDashboardConfig(client.InClusterClient(), ctx.Done())
 
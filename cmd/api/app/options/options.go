@@ -57,6 +57,10 @@ type Options struct {
 	OIDCClientSecret string
 	OIDCRedirectURL  string
 	OIDCScopes       []string
+
+	// Drift detection related options
+	DriftScanInterval   time.Duration
+	DriftScanNamespaces []string
 }
 
 // NewOptions returns initialized Options.
@@ -101,4 +105,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.OIDCClientSecret, "oidc-client-secret", "", "OIDC client secret")
 	fs.StringVar(&o.OIDCRedirectURL, "oidc-redirect-url", "", "OIDC redirect URL (e.g., https://dashboard.example.com/login/callback)")
 	fs.StringSliceVar(&o.OIDCScopes, "oidc-scopes", []string{"openid", "email", "groups", "profile"}, "OIDC scopes to request")
+
+	// Drift detection related flags
+	fs.DurationVar(&o.DriftScanInterval, "drift-scan-interval", 0, "Interval of the scheduled drift scan between resource templates and member cluster objects, 0 disables the scan")
+	fs.StringSliceVar(&o.DriftScanNamespaces, "drift-scan-namespaces", nil, "Namespaces checked by the scheduled drift scan, all namespaces with ResourceBindings when empty")
 }
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregate
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/aggregate"
+)
+
+func parseAggregateOptions(c *gin.Context) (aggregate.Options, error) {
+	aggregateRequest := new(v1.AggregateRequest)
+	if err := c.ShouldBindQuery(aggregateRequest); err != nil {
+		return aggregate.Options{}, err
+	}
+	opts := aggregate.Options{
+		ClusterSelector: aggregateRequest.ClusterSelector,
+		Concurrency:     aggregateRequest.Concurrency,
+		Timeout:         time.Duration(aggregateRequest.TimeoutSeconds) * time.Second,
+	}
+	for _, cluster := range strings.Split(aggregateRequest.Clusters, ",") {
+		if cluster = strings.TrimSpace(cluster); cluster != "" {
+			opts.Clusters = append(opts.Clusters, cluster)
+		}
+	}
+	return opts, nil
+}
+
+func handleGetAggregatePod(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts, err := parseAggregateOptions(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := aggregate.GetPodList(c, karmadaClient, opts, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetAggregatePodList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetAggregateDeployment(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts, err := parseAggregateOptions(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := aggregate.GetDeploymentList(c, karmadaClient, opts, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetAggregateDeploymentList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetAggregateService(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts, err := parseAggregateOptions(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := aggregate.GetServiceList(c, karmadaClient, opts, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetAggregateServiceList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetAggregateEvent(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts, err := parseAggregateOptions(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := aggregate.GetEventList(c, karmadaClient, opts, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetAggregateEventList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/aggregate/pod", handleGetAggregatePod)
+	r.GET("/aggregate/pod/:namespace", handleGetAggregatePod)
+	r.GET("/aggregate/deployment", handleGetAggregateDeployment)
+	r.GET("/aggregate/deployment/:namespace", handleGetAggregateDeployment)
+	r.GET("/aggregate/service", handleGetAggregateService)
+	r.GET("/aggregate/service/:namespace", handleGetAggregateService)
+	r.GET("/aggregate/event", handleGetAggregateEvent)
+	r.GET("/aggregate/event/:namespace", handleGetAggregateEvent)
+}
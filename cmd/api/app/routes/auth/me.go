@@ -39,6 +39,15 @@ func GetCurrentUser(c *gin.Context) (*v1.User, int, error) {
 	return me(c.Request)
 }
 
+// GetCurrentUserName returns the display name of the current user, or an empty string when it cannot be resolved.
+func GetCurrentUserName(c *gin.Context) string {
+	user, _, err := me(c.Request)
+	if err != nil || user == nil {
+		return ""
+	}
+	return user.Name
+}
+
 func me(request *http.Request) (*v1.User, int, error) {
 	karmadaClient, err := client.GetKarmadaClientFromRequest(request)
 	if err != nil {
@@ -25,15 +25,19 @@ import (
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	cmdutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
 	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
 )
 
 const (
@@ -239,36 +243,166 @@ func (o pullModeOption) makeKarmadaAgentDeployment() *appsv1.Deployment {
 	return karmadaAgent
 }
 
-func accessClusterInPullMode(opts *pullModeOption) error {
-	_, exist, err := karmadautil.GetClusterWithKarmadaClient(opts.karmadaClient, opts.memberClusterName)
-	if err != nil {
-		return err
+// pullModeJoinSteps and pushModeJoinSteps list the steps of a join in the order they run, so that the UI can render the
+// whole checklist as soon as the operation starts.
+var (
+	pullModeJoinSteps = []string{
+		"Check cluster name",
+		"Ensure namespace in member cluster",
+		"Create karmada-agent secret and RBAC in member cluster",
+		"Create karmada-agent deployment",
+		"Wait for karmada-agent rollout",
+	}
+	pushModeJoinSteps = []string{
+		"Obtain cluster ID",
+		"Validate cluster registration",
+		"Obtain credentials from member cluster",
+		"Register cluster in control plane",
 	}
-	if exist {
-		return fmt.Errorf("failed to register as cluster with name %s already exists", opts.memberClusterName)
+)
+
+// deleteOnRollback registers del to run when the operation fails, unless get finds that the object
+// already existed before the join, so that a failed join only removes what it created itself.
+func deleteOnRollback(ctx context.Context, tracker *operation.Tracker, description string,
+	get func(ctx context.Context) error, del func(ctx context.Context) error) error {
+	err := get(ctx)
+	if err == nil {
+		return nil
 	}
-	// It's necessary to set the label of namespace to make sure that the namespace is created by Karmada.
-	labels := map[string]string{
-		karmadautil.ManagedByKarmadaLabel: karmadautil.ManagedByKarmadaLabelValue,
+	if !apierrors.IsNotFound(err) {
+		return err
 	}
-	// ensure namespace where the karmada-agent resources be deployed exists in the member cluster
-	if _, err = karmadautil.EnsureNamespaceExistWithLabels(opts.memberClusterClient, opts.memberClusterNamespace, false, labels); err != nil {
+	tracker.OnRollback("Delete "+description, func() error {
+		if err := del(ctx); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return nil
+}
+
+func accessClusterInPullMode(ctx context.Context, tracker *operation.Tracker, opts *pullModeOption) error {
+	memberClient := opts.memberClusterClient
+	ns := opts.memberClusterNamespace
+	err := tracker.Step("Check cluster name", func() error {
+		_, exist, err := karmadautil.GetClusterWithKarmadaClient(opts.karmadaClient, opts.memberClusterName)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return fmt.Errorf("failed to register as cluster with name %s already exists", opts.memberClusterName)
+		}
+		return nil
+	})
+	if err != nil {
 		return err
 	}
-	if err = opts.createSecretAndRBACInMemberCluster(); err != nil {
+
+	err = tracker.Step("Ensure namespace in member cluster", func() error {
+		if err := deleteOnRollback(ctx, tracker, fmt.Sprintf("namespace %s", ns),
+			func(ctx context.Context) error {
+				_, err := memberClient.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{})
+				return err
+			},
+			func(ctx context.Context) error {
+				return memberClient.CoreV1().Namespaces().Delete(ctx, ns, metav1.DeleteOptions{})
+			}); err != nil {
+			return err
+		}
+		// It's necessary to set the label of namespace to make sure that the namespace is created by Karmada.
+		labels := map[string]string{
+			karmadautil.ManagedByKarmadaLabel: karmadautil.ManagedByKarmadaLabelValue,
+		}
+		// ensure namespace where the karmada-agent resources be deployed exists in the member cluster
+		_, err := karmadautil.EnsureNamespaceExistWithLabels(memberClient, ns, false, labels)
+		return err
+	})
+	if err != nil {
 		return err
 	}
+
+	err = tracker.Step("Create karmada-agent secret and RBAC in member cluster", func() error {
+		rollbacks := []struct {
+			description string
+			get         func(ctx context.Context) error
+			del         func(ctx context.Context) error
+		}{
+			{
+				description: fmt.Sprintf("secret %s/%s", ns, KarmadaKubeconfigName),
+				get: func(ctx context.Context) error {
+					_, err := memberClient.CoreV1().Secrets(ns).Get(ctx, KarmadaKubeconfigName, metav1.GetOptions{})
+					return err
+				},
+				del: func(ctx context.Context) error {
+					return memberClient.CoreV1().Secrets(ns).Delete(ctx, KarmadaKubeconfigName, metav1.DeleteOptions{})
+				},
+			},
+			{
+				description: fmt.Sprintf("clusterrole %s", KarmadaAgentName),
+				get: func(ctx context.Context) error {
+					_, err := memberClient.RbacV1().ClusterRoles().Get(ctx, KarmadaAgentName, metav1.GetOptions{})
+					return err
+				},
+				del: func(ctx context.Context) error {
+					return memberClient.RbacV1().ClusterRoles().Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+				},
+			},
+			{
+				description: fmt.Sprintf("serviceaccount %s/%s", ns, KarmadaAgentServiceAccountName),
+				get: func(ctx context.Context) error {
+					_, err := memberClient.CoreV1().ServiceAccounts(ns).Get(ctx, KarmadaAgentServiceAccountName, metav1.GetOptions{})
+					return err
+				},
+				del: func(ctx context.Context) error {
+					return memberClient.CoreV1().ServiceAccounts(ns).Delete(ctx, KarmadaAgentServiceAccountName, metav1.DeleteOptions{})
+				},
+			},
+			{
+				description: fmt.Sprintf("clusterrolebinding %s", KarmadaAgentName),
+				get: func(ctx context.Context) error {
+					_, err := memberClient.RbacV1().ClusterRoleBindings().Get(ctx, KarmadaAgentName, metav1.GetOptions{})
+					return err
+				},
+				del: func(ctx context.Context) error {
+					return memberClient.RbacV1().ClusterRoleBindings().Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+				},
+			},
+		}
+		for _, r := range rollbacks {
+			if err := deleteOnRollback(ctx, tracker, r.description, r.get, r.del); err != nil {
+				return err
+			}
+		}
+		return opts.createSecretAndRBACInMemberCluster()
+	})
+	if err != nil {
+		return err
+	}
+
 	karmadaAgentDeployment := opts.makeKarmadaAgentDeployment()
-	if _, err = opts.memberClusterClient.AppsV1().Deployments(opts.memberClusterNamespace).Create(context.TODO(), karmadaAgentDeployment, metav1.CreateOptions{}); err != nil {
+	err = tracker.Step("Create karmada-agent deployment", func() error {
+		if _, err := memberClient.AppsV1().Deployments(ns).Create(ctx, karmadaAgentDeployment, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		tracker.OnRollback(fmt.Sprintf("Delete deployment %s/%s", ns, KarmadaAgentName), func() error {
+			err := memberClient.AppsV1().Deployments(ns).Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			return nil
+		})
+		return nil
+	})
+	if err != nil {
 		return err
 	}
+
 	// TODO: deployment ready cannot exactly express that cluster is ready
 	// It should also check cluster resource on karmada control-plane
 	// maybe karmadactl should optimized it
-	if err = cmdutil.WaitForDeploymentRollout(opts.memberClusterClient, karmadaAgentDeployment, timeout); err != nil {
-		return err
-	}
-	return nil
+	return tracker.Step("Wait for karmada-agent rollout", func() error {
+		return cmdutil.WaitForDeploymentRollout(memberClient, karmadaAgentDeployment, timeout)
+	})
 }
 
 type pushModeOption struct {
@@ -278,7 +412,7 @@ type pushModeOption struct {
 	memberClusterRestConfig *rest.Config
 }
 
-func accessClusterInPushMode(opts *pushModeOption) error {
+func accessClusterInPushMode(ctx context.Context, tracker *operation.Tracker, opts *pushModeOption) error {
 	registerOption := karmadautil.ClusterRegisterOption{
 		ClusterNamespace:   ClusterNamespace,
 		ClusterName:        opts.clusterName,
@@ -289,37 +423,144 @@ func accessClusterInPushMode(opts *pushModeOption) error {
 
 	controlPlaneKubeClient := kubeclient.NewForConfigOrDie(opts.karmadaRestConfig)
 	memberClusterKubeClient := kubeclient.NewForConfigOrDie(opts.memberClusterRestConfig)
-	clusterID, err := karmadautil.ObtainClusterID(memberClusterKubeClient)
+	err := tracker.Step("Obtain cluster ID", func() error {
+		clusterID, err := karmadautil.ObtainClusterID(memberClusterKubeClient)
+		if err != nil {
+			klog.ErrorS(err, "ObtainClusterID failed")
+			return err
+		}
+		registerOption.ClusterID = clusterID
+		return nil
+	})
 	if err != nil {
-		klog.ErrorS(err, "ObtainClusterID failed")
 		return err
 	}
-	registerOption.ClusterID = clusterID
 
-	if err = registerOption.Validate(opts.karmadaClient, true); err != nil {
+	err = tracker.Step("Validate cluster registration", func() error {
+		return registerOption.Validate(opts.karmadaClient, true)
+	})
+	if err != nil {
 		return err
 	}
 
-	clusterSecret, impersonatorSecret, err := karmadautil.ObtainCredentialsFromMemberCluster(memberClusterKubeClient, registerOption)
+	err = tracker.Step("Obtain credentials from member cluster", func() error {
+		if err := rollbackMemberCredentials(ctx, tracker, memberClusterKubeClient, opts.clusterName); err != nil {
+			return err
+		}
+		clusterSecret, impersonatorSecret, err := karmadautil.ObtainCredentialsFromMemberCluster(memberClusterKubeClient, registerOption)
+		if err != nil {
+			klog.ErrorS(err, "ObtainCredentialsFromMemberCluster failed")
+			return err
+		}
+		if clusterSecret != nil {
+			registerOption.Secret = *clusterSecret
+		}
+		if impersonatorSecret != nil {
+			registerOption.ImpersonatorSecret = *impersonatorSecret
+		}
+		return nil
+	})
 	if err != nil {
-		klog.ErrorS(err, "ObtainCredentialsFromMemberCluster failed")
 		return err
 	}
-	if clusterSecret != nil {
-		registerOption.Secret = *clusterSecret
-	}
-	if impersonatorSecret != nil {
-		registerOption.ImpersonatorSecret = *impersonatorSecret
-	}
 
-	err = karmadautil.RegisterClusterInControllerPlane(registerOption, controlPlaneKubeClient, generateClusterInControllerPlane)
+	err = tracker.Step("Register cluster in control plane", func() error {
+		if err := rollbackControlPlaneRegistration(ctx, tracker, controlPlaneKubeClient, opts.karmadaClient, opts.clusterName); err != nil {
+			return err
+		}
+		if err := karmadautil.RegisterClusterInControllerPlane(registerOption, controlPlaneKubeClient, generateClusterInControllerPlane); err != nil {
+			return fmt.Errorf("failed to register with karmada control plane: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to register with karmada control plane: %w", err)
+		return err
 	}
 	klog.Infof("cluster(%s) is joined successfully\n", opts.clusterName)
 	return nil
 }
 
+// rollbackMemberCredentials registers the cleanup of the namespace, service accounts and RBAC that
+// ObtainCredentialsFromMemberCluster creates in the member cluster.
+func rollbackMemberCredentials(ctx context.Context, tracker *operation.Tracker, memberClient kubeclient.Interface, clusterName string) error {
+	serviceAccounts := []string{
+		names.GenerateServiceAccountName("impersonator"),
+		names.GenerateServiceAccountName(clusterName),
+	}
+	roleName := names.GenerateRoleName(names.GenerateServiceAccountName(clusterName))
+
+	if err := deleteOnRollback(ctx, tracker, fmt.Sprintf("namespace %s in member cluster", ClusterNamespace),
+		func(ctx context.Context) error {
+			_, err := memberClient.CoreV1().Namespaces().Get(ctx, ClusterNamespace, metav1.GetOptions{})
+			return err
+		},
+		func(ctx context.Context) error {
+			return memberClient.CoreV1().Namespaces().Delete(ctx, ClusterNamespace, metav1.DeleteOptions{})
+		}); err != nil {
+		return err
+	}
+	for _, sa := range serviceAccounts {
+		if err := deleteOnRollback(ctx, tracker, fmt.Sprintf("serviceaccount %s/%s in member cluster", ClusterNamespace, sa),
+			func(ctx context.Context) error {
+				_, err := memberClient.CoreV1().ServiceAccounts(ClusterNamespace).Get(ctx, sa, metav1.GetOptions{})
+				return err
+			},
+			func(ctx context.Context) error {
+				return memberClient.CoreV1().ServiceAccounts(ClusterNamespace).Delete(ctx, sa, metav1.DeleteOptions{})
+			}); err != nil {
+			return err
+		}
+	}
+	if err := deleteOnRollback(ctx, tracker, fmt.Sprintf("clusterrole %s in member cluster", roleName),
+		func(ctx context.Context) error {
+			_, err := memberClient.RbacV1().ClusterRoles().Get(ctx, roleName, metav1.GetOptions{})
+			return err
+		},
+		func(ctx context.Context) error {
+			return memberClient.RbacV1().ClusterRoles().Delete(ctx, roleName, metav1.DeleteOptions{})
+		}); err != nil {
+		return err
+	}
+	return deleteOnRollback(ctx, tracker, fmt.Sprintf("clusterrolebinding %s in member cluster", roleName),
+		func(ctx context.Context) error {
+			_, err := memberClient.RbacV1().ClusterRoleBindings().Get(ctx, roleName, metav1.GetOptions{})
+			return err
+		},
+		func(ctx context.Context) error {
+			return memberClient.RbacV1().ClusterRoleBindings().Delete(ctx, roleName, metav1.DeleteOptions{})
+		})
+}
+
+// rollbackControlPlaneRegistration registers the cleanup of the secrets and the Cluster object that
+// RegisterClusterInControllerPlane creates in the karmada control plane.
+func rollbackControlPlaneRegistration(ctx context.Context, tracker *operation.Tracker, controlPlaneClient kubeclient.Interface,
+	karmadaClient karmadaclientset.Interface, clusterName string) error {
+	secrets := []string{
+		names.GenerateImpersonationSecretName(clusterName),
+		clusterName,
+	}
+	for _, secret := range secrets {
+		if err := deleteOnRollback(ctx, tracker, fmt.Sprintf("secret %s/%s in control plane", ClusterNamespace, secret),
+			func(ctx context.Context) error {
+				_, err := controlPlaneClient.CoreV1().Secrets(ClusterNamespace).Get(ctx, secret, metav1.GetOptions{})
+				return err
+			},
+			func(ctx context.Context) error {
+				return controlPlaneClient.CoreV1().Secrets(ClusterNamespace).Delete(ctx, secret, metav1.DeleteOptions{})
+			}); err != nil {
+			return err
+		}
+	}
+	return deleteOnRollback(ctx, tracker, fmt.Sprintf("cluster %s", clusterName),
+		func(ctx context.Context) error {
+			_, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+			return err
+		},
+		func(ctx context.Context) error {
+			return karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, clusterName, metav1.DeleteOptions{})
+		})
+}
+
 func generateClusterInControllerPlane(opts karmadautil.ClusterRegisterOption) (*clusterv1alpha1.Cluster, error) {
 	clusterObj := &clusterv1alpha1.Cluster{}
 	clusterObj.Name = opts.ClusterName
@@ -0,0 +1,533 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+const (
+	credentialsStepValidate = "Validate member cluster"
+	credentialsStepObtain   = "Obtain credentials from member cluster"
+	credentialsStepVerify   = "Verify new credentials"
+	credentialsStepUpdate   = "Update credentials in control plane"
+	credentialsStepWait     = "Wait for cluster to become Ready"
+	credentialsStepRevoke   = "Revoke previous tokens"
+
+	// credentialProbeTimeout bounds each request made to verify credentials or read certificates.
+	credentialProbeTimeout = 10 * time.Second
+	// tokenIssueTimeout bounds the wait for the token controller of the member cluster to issue a
+	// requested token.
+	tokenIssueTimeout = 30 * time.Second
+)
+
+var (
+	// newClientForConfig builds the clients to the member cluster, it is replaced in tests.
+	newClientForConfig = func(config *rest.Config) (kubeclient.Interface, error) {
+		return kubeclient.NewForConfig(config)
+	}
+	// tokenPollInterval is how often an issued token is looked up, it is shortened in tests.
+	tokenPollInterval = time.Second
+	// clusterReadyPollInterval is how often the cluster is looked up while waiting for it to be Ready.
+	clusterReadyPollInterval = 2 * time.Second
+	// clusterReadyStablePeriod is how long a cluster that was Ready before the rotation must stay Ready
+	// before the new credentials are trusted. It covers a status probe with the new secret plus the
+	// failure threshold the cluster status controller waits for before it reports NotReady.
+	clusterReadyStablePeriod = 45 * time.Second
+)
+
+// rotateCredentialsSteps lists the steps rotateClusterCredentials runs for the given options.
+func rotateCredentialsSteps(opts *rotateCredentialsOption) []string {
+	steps := []string{
+		credentialsStepValidate,
+		credentialsStepObtain,
+		credentialsStepVerify,
+		credentialsStepUpdate,
+		credentialsStepWait,
+	}
+	if opts.regenerateToken {
+		steps = append(steps, credentialsStepRevoke)
+	}
+	return steps
+}
+
+// CredentialExpiry is the expiry of a certificate or token used to access a member cluster.
+type CredentialExpiry struct {
+	// Source tells where the credential comes from, e.g. caBundle or servingCertificate.
+	Source   string      `json:"source"`
+	Subject  string      `json:"subject,omitempty"`
+	NotAfter metav1.Time `json:"notAfter"`
+	// Expiring is set when the credential expires within the warning period, or already expired.
+	Expiring bool `json:"expiring"`
+}
+
+// CredentialReport lists the expiries of the credentials of a push mode cluster.
+type CredentialReport struct {
+	Cluster  string             `json:"cluster"`
+	Expiries []CredentialExpiry `json:"expiries"`
+	Warnings []string           `json:"warnings"`
+
+	warnBefore time.Duration
+}
+
+type rotateCredentialsOption struct {
+	karmadaClient      karmadaclientset.Interface
+	controlPlaneClient kubeclient.Interface
+	clusterName        string
+	// memberClusterKubeConfig must grant cluster-admin in the member cluster, it is only used to
+	// obtain the credentials karmada keeps, not stored itself.
+	memberClusterKubeConfig string
+	// regenerateToken issues new service account tokens in the member cluster, e.g. after the
+	// service account signing key rotated. The previous tokens are revoked once the cluster is Ready
+	// with the new ones.
+	regenerateToken bool
+	timeout         time.Duration
+	warnBefore      time.Duration
+}
+
+// rotateClusterCredentials replaces the credentials of a push mode cluster in place, keeping the
+// Cluster object and everything scheduled to it. The previous credentials are restored when the
+// cluster does not become Ready with the new ones, which is why previous tokens are only revoked at
+// the very end.
+func rotateClusterCredentials(ctx context.Context, tracker *operation.Tracker, opts *rotateCredentialsOption) error {
+	var cluster *clusterv1alpha1.Cluster
+	var memberRestConfig *rest.Config
+	var memberClient kubeclient.Interface
+	err := tracker.Step(credentialsStepValidate, func() error {
+		var err error
+		cluster, err = opts.karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, opts.clusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if cluster.Spec.SyncMode != clusterv1alpha1.Push || cluster.Spec.SecretRef == nil {
+			return fmt.Errorf("cluster %s is not a push mode cluster with credentials, pull mode clusters rotate their own certificates", opts.clusterName)
+		}
+		if memberRestConfig, err = client.LoadRestConfigFromKubeConfig(opts.memberClusterKubeConfig); err != nil {
+			return fmt.Errorf("failed to load the member cluster kubeconfig: %w", err)
+		}
+		if memberClient, err = newClientForConfig(memberRestConfig); err != nil {
+			return err
+		}
+		clusterID, err := karmadautil.ObtainClusterID(memberClient)
+		if err != nil {
+			return fmt.Errorf("failed to reach the member cluster: %w", err)
+		}
+		if cluster.Spec.ID != "" && clusterID != cluster.Spec.ID {
+			return fmt.Errorf("the kubeconfig points at cluster %s, not at cluster %s with id %s", clusterID, opts.clusterName, cluster.Spec.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	reportSecrets := []string{karmadautil.KubeCredentials}
+	if cluster.Spec.ImpersonatorSecretRef != nil {
+		reportSecrets = append(reportSecrets, karmadautil.KubeImpersonator)
+	}
+	var clusterSecret, impersonatorSecret *corev1.Secret
+	err = tracker.Step(credentialsStepObtain, func() error {
+		var err error
+		clusterSecret, impersonatorSecret, err = karmadautil.ObtainCredentialsFromMemberCluster(memberClient, karmadautil.ClusterRegisterOption{
+			ClusterNamespace: ClusterNamespace,
+			ClusterName:      opts.clusterName,
+			ReportSecrets:    reportSecrets,
+		})
+		if err != nil || !opts.regenerateToken {
+			return err
+		}
+		// the current tokens stay valid until the new ones are in use
+		if clusterSecret, err = issueServiceAccountToken(ctx, tracker, memberClient, names.GenerateServiceAccountName(opts.clusterName)); err != nil {
+			return err
+		}
+		if impersonatorSecret != nil {
+			impersonatorSecret, err = issueServiceAccountToken(ctx, tracker, memberClient, names.GenerateServiceAccountName("impersonator"))
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// the control plane reaches the member at the endpoint of the new kubeconfig from now on
+	endpoint := memberRestConfig.Host
+	err = tracker.Step(credentialsStepVerify, func() error {
+		verifyConfig := &rest.Config{
+			Host:            endpoint,
+			BearerToken:     string(clusterSecret.Data[clusterv1alpha1.SecretTokenKey]),
+			TLSClientConfig: rest.TLSClientConfig{Insecure: memberRestConfig.Insecure},
+			Timeout:         credentialProbeTimeout,
+		}
+		// client-go refuses a root CA together with the insecure flag
+		if !memberRestConfig.Insecure {
+			verifyConfig.CAData = clusterSecret.Data["ca.crt"]
+		}
+		if cluster.Spec.ProxyURL != "" {
+			proxyURL, err := url.Parse(cluster.Spec.ProxyURL)
+			if err != nil {
+				return fmt.Errorf("failed to parse proxy URL of cluster %s: %w", opts.clusterName, err)
+			}
+			verifyConfig.Proxy = http.ProxyURL(proxyURL)
+		}
+		verifyClient, err := newClientForConfig(verifyConfig)
+		if err != nil {
+			return err
+		}
+		if _, err = verifyClient.CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{}); err != nil {
+			return fmt.Errorf("the member cluster rejected the new credentials: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var updatedAt time.Time
+	err = tracker.Step(credentialsStepUpdate, func() error {
+		updatedAt = time.Now()
+		if err := updateSecretData(ctx, tracker, opts.controlPlaneClient, cluster.Spec.SecretRef, map[string][]byte{
+			clusterv1alpha1.SecretCADataKey: clusterSecret.Data["ca.crt"],
+			clusterv1alpha1.SecretTokenKey:  clusterSecret.Data[clusterv1alpha1.SecretTokenKey],
+		}); err != nil {
+			return err
+		}
+		if cluster.Spec.ImpersonatorSecretRef != nil && impersonatorSecret != nil {
+			if err := updateSecretData(ctx, tracker, opts.controlPlaneClient, cluster.Spec.ImpersonatorSecretRef, map[string][]byte{
+				clusterv1alpha1.SecretTokenKey: impersonatorSecret.Data[clusterv1alpha1.SecretTokenKey],
+			}); err != nil {
+				return err
+			}
+		}
+		if cluster.Spec.APIEndpoint != endpoint || cluster.Spec.InsecureSkipTLSVerification != memberRestConfig.Insecure {
+			return updateClusterEndpoint(ctx, tracker, opts.karmadaClient, opts.clusterName, endpoint, memberRestConfig.Insecure)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tracker.Step(credentialsStepWait, func() error {
+		return waitForReadyWithNewCredentials(ctx, opts.karmadaClient, opts.clusterName, updatedAt, opts.timeout)
+	})
+	if err != nil {
+		return fmt.Errorf("cluster %s did not become Ready with the new credentials: %w", opts.clusterName, err)
+	}
+
+	report := newCredentialReport(opts.clusterName, opts.warnBefore)
+	if opts.regenerateToken {
+		// the cluster works with the new tokens, a failure to revoke the previous ones is reported
+		// instead of rolling back
+		keep := []*corev1.Secret{clusterSecret, impersonatorSecret}
+		if err := tracker.Step(credentialsStepRevoke, func() error {
+			return revokeServiceAccountTokens(ctx, memberClient, keep)
+		}); err != nil {
+			klog.ErrorS(err, "Failed to revoke previous tokens", "cluster", opts.clusterName)
+			report.Warnings = append(report.Warnings, fmt.Sprintf("previous tokens are still valid: %v", err))
+		}
+	}
+	report.addCertificates("caBundle", clusterSecret.Data["ca.crt"])
+	report.addToken("token", clusterSecret.Data[clusterv1alpha1.SecretTokenKey])
+	report.addCertificates("kubeconfigClientCertificate", memberRestConfig.CertData)
+	report.addServingCertificate(endpoint)
+	tracker.SetResult(report)
+	return nil
+}
+
+// waitForReadyWithNewCredentials waits until the Ready condition of the cluster reflects a probe made
+// after updatedAt. A transition to Ready after updatedAt does, otherwise the cluster has to stay Ready
+// for clusterReadyStablePeriod, as the condition of a cluster that was Ready before only changes once
+// the probes with the new secret fail. timeout bounds the wait for Ready, the stable period comes on top.
+func waitForReadyWithNewCredentials(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string,
+	updatedAt time.Time, timeout time.Duration) error {
+	var readySince time.Time
+	return wait.PollUntilContextTimeout(ctx, clusterReadyPollInterval, timeout+clusterReadyStablePeriod, true, func(ctx context.Context) (bool, error) {
+		current, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		ready := meta.FindStatusCondition(current.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+		if ready == nil || ready.Status != metav1.ConditionTrue {
+			readySince = time.Time{}
+			return false, nil
+		}
+		if ready.LastTransitionTime.After(updatedAt) {
+			return true, nil
+		}
+		if readySince.IsZero() {
+			readySince = time.Now()
+		}
+		return time.Since(readySince) >= clusterReadyStablePeriod, nil
+	})
+}
+
+// issueServiceAccountToken requests a new token for the service account of the member cluster next to
+// the existing ones, and returns the secret holding it. The secret is deleted on rollback.
+func issueServiceAccountToken(ctx context.Context, tracker *operation.Tracker, memberClient kubeclient.Interface, serviceAccount string) (*corev1.Secret, error) {
+	secret, err := memberClient.CoreV1().Secrets(ClusterNamespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   ClusterNamespace,
+			Name:        fmt.Sprintf("%s-%s", serviceAccount, utilrand.String(5)),
+			Labels:      map[string]string{karmadautil.KarmadaSystemLabel: karmadautil.KarmadaSystemLabelValue},
+			Annotations: map[string]string{corev1.ServiceAccountNameKey: serviceAccount},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request a new token for %s/%s in member cluster: %w", ClusterNamespace, serviceAccount, err)
+	}
+	tracker.OnRollback(fmt.Sprintf("delete new token secret %s/%s in member cluster", ClusterNamespace, secret.Name), func() error {
+		err := memberClient.CoreV1().Secrets(ClusterNamespace).Delete(context.TODO(), secret.Name, metav1.DeleteOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+
+	err = wait.PollUntilContextTimeout(ctx, tokenPollInterval, tokenIssueTimeout, true, func(ctx context.Context) (bool, error) {
+		secret, err = memberClient.CoreV1().Secrets(ClusterNamespace).Get(ctx, secret.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return len(secret.Data[corev1.ServiceAccountTokenKey]) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("the member cluster did not issue a token for %s/%s: %w", ClusterNamespace, serviceAccount, err)
+	}
+	return secret, nil
+}
+
+// revokeServiceAccountTokens deletes the token secrets of the service accounts of the kept secrets,
+// except the kept secrets themselves.
+func revokeServiceAccountTokens(ctx context.Context, memberClient kubeclient.Interface, keep []*corev1.Secret) error {
+	serviceAccounts := map[string]bool{}
+	kept := map[string]bool{}
+	for _, secret := range keep {
+		if secret == nil {
+			continue
+		}
+		serviceAccounts[secret.Annotations[corev1.ServiceAccountNameKey]] = true
+		kept[secret.Name] = true
+	}
+	secrets, err := memberClient.CoreV1().Secrets(ClusterNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, secret := range secrets.Items {
+		if secret.Type != corev1.SecretTypeServiceAccountToken || kept[secret.Name] ||
+			!serviceAccounts[secret.Annotations[corev1.ServiceAccountNameKey]] {
+			continue
+		}
+		err := memberClient.CoreV1().Secrets(ClusterNamespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete token secret %s/%s in member cluster: %w", ClusterNamespace, secret.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// updateSecretData replaces the given keys of the secret, the previous data is restored on rollback.
+func updateSecretData(ctx context.Context, tracker *operation.Tracker, controlPlaneClient kubeclient.Interface,
+	ref *clusterv1alpha1.LocalSecretReference, data map[string][]byte) error {
+	secret, err := controlPlaneClient.CoreV1().Secrets(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	previous := secret.DeepCopy().Data
+	tracker.OnRollback(fmt.Sprintf("restore secret %s/%s in control plane", ref.Namespace, ref.Name), func() error {
+		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			current, err := controlPlaneClient.CoreV1().Secrets(ref.Namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			current.Data = previous
+			_, err = controlPlaneClient.CoreV1().Secrets(ref.Namespace).Update(context.TODO(), current, metav1.UpdateOptions{})
+			return err
+		})
+	})
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range data {
+		secret.Data[key] = value
+	}
+	_, err = controlPlaneClient.CoreV1().Secrets(ref.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// updateClusterEndpoint points the cluster at a new API endpoint, the previous one is restored on rollback.
+func updateClusterEndpoint(ctx context.Context, tracker *operation.Tracker, karmadaClient karmadaclientset.Interface,
+	clusterName, endpoint string, insecure bool) error {
+	set := func(ctx context.Context, endpoint string, insecure bool) (string, bool, error) {
+		var previousEndpoint string
+		var previousInsecure bool
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			previousEndpoint, previousInsecure = cluster.Spec.APIEndpoint, cluster.Spec.InsecureSkipTLSVerification
+			cluster.Spec.APIEndpoint, cluster.Spec.InsecureSkipTLSVerification = endpoint, insecure
+			_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+			return err
+		})
+		return previousEndpoint, previousInsecure, err
+	}
+	previousEndpoint, previousInsecure, err := set(ctx, endpoint, insecure)
+	if err != nil {
+		return err
+	}
+	tracker.OnRollback(fmt.Sprintf("restore API endpoint %s of cluster %s", previousEndpoint, clusterName), func() error {
+		_, _, err := set(context.TODO(), previousEndpoint, previousInsecure)
+		return err
+	})
+	return nil
+}
+
+// getCredentialReport reads the expiries of the credentials karmada uses to access the cluster.
+func getCredentialReport(ctx context.Context, karmadaClient karmadaclientset.Interface, controlPlaneClient kubeclient.Interface,
+	clusterName string, warnBefore time.Duration) (*CredentialReport, error) {
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report := newCredentialReport(clusterName, warnBefore)
+	if cluster.Spec.SecretRef == nil {
+		report.Warnings = append(report.Warnings, "the cluster has no credentials in the control plane, pull mode clusters are accessed by karmada-agent")
+		return report, nil
+	}
+	secret, err := controlPlaneClient.CoreV1().Secrets(cluster.Spec.SecretRef.Namespace).Get(ctx, cluster.Spec.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report.addCertificates("caBundle", secret.Data[clusterv1alpha1.SecretCADataKey])
+	report.addToken("token", secret.Data[clusterv1alpha1.SecretTokenKey])
+	report.addServingCertificate(cluster.Spec.APIEndpoint)
+	return report, nil
+}
+
+func newCredentialReport(clusterName string, warnBefore time.Duration) *CredentialReport {
+	return &CredentialReport{
+		Cluster:    clusterName,
+		Expiries:   make([]CredentialExpiry, 0),
+		Warnings:   make([]string, 0),
+		warnBefore: warnBefore,
+	}
+}
+
+func (r *CredentialReport) add(source, subject string, notAfter time.Time) {
+	expiring := time.Until(notAfter) < r.warnBefore
+	r.Expiries = append(r.Expiries, CredentialExpiry{
+		Source:   source,
+		Subject:  subject,
+		NotAfter: metav1.NewTime(notAfter),
+		Expiring: expiring,
+	})
+	switch {
+	case !notAfter.After(time.Now()):
+		r.Warnings = append(r.Warnings, fmt.Sprintf("%s %s expired at %s", source, subject, notAfter.Format(time.RFC3339)))
+	case expiring:
+		r.Warnings = append(r.Warnings, fmt.Sprintf("%s %s expires at %s", source, subject, notAfter.Format(time.RFC3339)))
+	}
+}
+
+func (r *CredentialReport) addCertificates(source string, pemData []byte) {
+	if len(pemData) == 0 {
+		return
+	}
+	certs, err := certutil.ParseCertsPEM(pemData)
+	if err != nil {
+		r.Warnings = append(r.Warnings, fmt.Sprintf("failed to parse %s: %v", source, err))
+		return
+	}
+	for _, cert := range certs {
+		r.add(source, cert.Subject.CommonName, cert.NotAfter)
+	}
+}
+
+// addToken adds the expiry of a JWT token, legacy service account tokens never expire.
+func (r *CredentialReport) addToken(source string, token []byte) {
+	parts := strings.Split(string(token), ".")
+	if len(parts) != 3 {
+		return
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return
+	}
+	claims := struct {
+		Subject   string `json:"sub"`
+		ExpiresAt int64  `json:"exp"`
+	}{}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.ExpiresAt == 0 {
+		return
+	}
+	r.add(source, claims.Subject, time.Unix(claims.ExpiresAt, 0))
+}
+
+// addServingCertificate adds the expiry of the certificate the member apiserver serves. The chain is
+// read without being verified, only its expiry matters here.
+func (r *CredentialReport) addServingCertificate(endpoint string) {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Scheme != "https" {
+		return
+	}
+	dialer := &net.Dialer{Timeout: credentialProbeTimeout}
+	// #nosec G402 -- the connection is only used to read the certificate expiry
+	conn, err := tls.DialWithDialer(dialer, "tcp", endpointAddress(endpointURL), &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		r.Warnings = append(r.Warnings, fmt.Sprintf("failed to read the serving certificate of %s: %v", endpoint, err))
+		return
+	}
+	defer conn.Close()
+	if certs := conn.ConnectionState().PeerCertificates; len(certs) > 0 {
+		r.add("servingCertificate", certs[0].Subject.CommonName, certs[0].NotAfter)
+	}
+}
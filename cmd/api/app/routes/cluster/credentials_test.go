@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+const insecureMemberKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: member1
+  cluster:
+    server: https://127.0.0.1:1
+    insecure-skip-tls-verify: true
+users:
+- name: admin
+  user:
+    token: admin-token
+contexts:
+- name: member1
+  context:
+    cluster: member1
+    user: admin
+current-context: member1
+`
+
+// newRotationFixture returns the clients of a Ready push mode cluster whose control plane secret
+// holds the token "old", and a member cluster that issues the token "new" for requested secrets.
+func newRotationFixture() (*karmadafake.Clientset, *fake.Clientset, *fake.Clientset) {
+	karmadaClient := karmadafake.NewSimpleClientset(&clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Spec: clusterv1alpha1.ClusterSpec{
+			SyncMode:                    clusterv1alpha1.Push,
+			APIEndpoint:                 "https://127.0.0.1:1",
+			InsecureSkipTLSVerification: true,
+			SecretRef:                   &clusterv1alpha1.LocalSecretReference{Namespace: ClusterNamespace, Name: "member1"},
+		},
+		Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{
+			Type: clusterv1alpha1.ClusterConditionReady, Status: metav1.ConditionTrue,
+		}}},
+	})
+	controlPlaneClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ClusterNamespace, Name: "member1"},
+		Data:       map[string][]byte{clusterv1alpha1.SecretTokenKey: []byte("old")},
+	})
+
+	serviceAccount := names.GenerateServiceAccountName("member1")
+	memberClient := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceSystem, UID: "member1-id"}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ClusterNamespace, Name: serviceAccount,
+				Annotations: map[string]string{corev1.ServiceAccountNameKey: serviceAccount},
+			},
+			Type: corev1.SecretTypeServiceAccountToken,
+			Data: map[string][]byte{corev1.ServiceAccountTokenKey: []byte("old"), "ca.crt": []byte("ca")},
+		},
+	)
+	// play the token controller of the member cluster
+	memberClient.PrependReactor("create", "secrets", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		secret := action.(clienttesting.CreateAction).GetObject().(*corev1.Secret)
+		if secret.Type == corev1.SecretTypeServiceAccountToken {
+			secret.Data = map[string][]byte{corev1.ServiceAccountTokenKey: []byte("new"), "ca.crt": []byte("ca")}
+		}
+		return false, nil, nil
+	})
+	return karmadaClient, controlPlaneClient, memberClient
+}
+
+func TestRotateClusterCredentials(t *testing.T) {
+	defer func(old time.Duration) { tokenPollInterval = old }(tokenPollInterval)
+	tokenPollInterval = 10 * time.Millisecond
+	defer func(interval, period time.Duration) {
+		clusterReadyPollInterval, clusterReadyStablePeriod = interval, period
+	}(clusterReadyPollInterval, clusterReadyStablePeriod)
+	clusterReadyPollInterval, clusterReadyStablePeriod = 10*time.Millisecond, 50*time.Millisecond
+	serviceAccount := names.GenerateServiceAccountName("member1")
+
+	tests := []struct {
+		name string
+		// verify answers the request made with the new credentials
+		verify error
+		// notReadyAfterUpdate reports the cluster NotReady once the control plane secret holds the new token
+		notReadyAfterUpdate bool
+		wantErr             bool
+		wantToken           string
+		// wantMemberTokens is the number of token secrets left in the member cluster
+		wantMemberTokens int
+		wantOldToken     bool
+	}{
+		{
+			name:             "insecure cluster gets new token and the previous one is revoked",
+			wantToken:        "new",
+			wantMemberTokens: 1,
+		},
+		{
+			name:             "rejected token is rolled back and the previous one kept",
+			verify:           apierrors.NewUnauthorized("invalid token"),
+			wantErr:          true,
+			wantToken:        "old",
+			wantMemberTokens: 1,
+			wantOldToken:     true,
+		},
+		{
+			name:                "cluster turning NotReady with the new token is rolled back",
+			notReadyAfterUpdate: true,
+			wantErr:             true,
+			wantToken:           "old",
+			wantMemberTokens:    1,
+			wantOldToken:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient, controlPlaneClient, memberClient := newRotationFixture()
+			verifyClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceSystem}})
+			if tt.verify != nil {
+				verifyClient.PrependReactor("get", "namespaces", func(clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.verify
+				})
+			}
+			if tt.notReadyAfterUpdate {
+				karmadaClient.PrependReactor("get", "clusters", func(clienttesting.Action) (bool, runtime.Object, error) {
+					secret, err := controlPlaneClient.CoreV1().Secrets(ClusterNamespace).Get(context.TODO(), "member1", metav1.GetOptions{})
+					if err != nil || string(secret.Data[clusterv1alpha1.SecretTokenKey]) != "new" {
+						return false, nil, err
+					}
+					return true, newReadyCluster(metav1.ConditionFalse, time.Now()), nil
+				})
+			}
+			defer func(old func(*rest.Config) (kubeclient.Interface, error)) { newClientForConfig = old }(newClientForConfig)
+			newClientForConfig = func(config *rest.Config) (kubeclient.Interface, error) {
+				// the same validation the real transport runs
+				if _, err := rest.TLSConfigFor(config); err != nil {
+					return nil, err
+				}
+				if config.BearerToken == "new" {
+					return verifyClient, nil
+				}
+				if config.BearerToken != "admin-token" {
+					return nil, fmt.Errorf("unexpected token %q", config.BearerToken)
+				}
+				return memberClient, nil
+			}
+
+			opts := &rotateCredentialsOption{
+				karmadaClient:           karmadaClient,
+				controlPlaneClient:      controlPlaneClient,
+				clusterName:             "member1",
+				memberClusterKubeConfig: insecureMemberKubeConfig,
+				regenerateToken:         true,
+				timeout:                 time.Second,
+			}
+			tracker := operation.New(rotateCredentialsOperation, "member1", rotateCredentialsSteps(opts)...)
+			err := rotateClusterCredentials(context.TODO(), tracker, opts)
+			tracker.Finish(err)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("rotateClusterCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			secret, err := controlPlaneClient.CoreV1().Secrets(ClusterNamespace).Get(context.TODO(), "member1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(secret.Data[clusterv1alpha1.SecretTokenKey]); got != tt.wantToken {
+				t.Errorf("control plane token = %q, want %q", got, tt.wantToken)
+			}
+			secrets, err := memberClient.CoreV1().Secrets(ClusterNamespace).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			tokens := 0
+			for _, s := range secrets.Items {
+				if s.Type == corev1.SecretTypeServiceAccountToken {
+					tokens++
+				}
+			}
+			if tokens != tt.wantMemberTokens {
+				t.Errorf("member token secrets = %d, want %d", tokens, tt.wantMemberTokens)
+			}
+			_, err = memberClient.CoreV1().Secrets(ClusterNamespace).Get(context.TODO(), serviceAccount, metav1.GetOptions{})
+			if gotOld := err == nil; gotOld != tt.wantOldToken {
+				t.Errorf("previous token secret kept = %v, want %v", gotOld, tt.wantOldToken)
+			}
+		})
+	}
+}
+
+// newReadyCluster returns cluster member1 with the given Ready condition.
+func newReadyCluster(status metav1.ConditionStatus, transition time.Time) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{
+			Type: clusterv1alpha1.ClusterConditionReady, Status: status, LastTransitionTime: metav1.NewTime(transition),
+		}}},
+	}
+}
+
+func TestWaitForReadyWithNewCredentials(t *testing.T) {
+	defer func(interval, period time.Duration) {
+		clusterReadyPollInterval, clusterReadyStablePeriod = interval, period
+	}(clusterReadyPollInterval, clusterReadyStablePeriod)
+	clusterReadyPollInterval, clusterReadyStablePeriod = 10*time.Millisecond, 100*time.Millisecond
+	updatedAt := time.Now()
+	before, after := updatedAt.Add(-time.Hour), updatedAt.Add(time.Second)
+
+	tests := []struct {
+		name string
+		// polls are the Ready conditions returned by consecutive lookups, the last one is repeated
+		polls   []*clusterv1alpha1.Cluster
+		wantErr bool
+		// minPolls and maxPolls bound the lookups of a successful wait, maxPolls 0 is unbounded
+		minPolls, maxPolls int
+	}{
+		{
+			name:     "cluster Ready before the update has to stay Ready",
+			polls:    []*clusterv1alpha1.Cluster{newReadyCluster(metav1.ConditionTrue, before)},
+			minPolls: 5,
+		},
+		{
+			name: "cluster turning Ready after the update passes right away",
+			polls: []*clusterv1alpha1.Cluster{
+				newReadyCluster(metav1.ConditionFalse, before),
+				newReadyCluster(metav1.ConditionTrue, after),
+			},
+			minPolls: 2,
+			maxPolls: 2,
+		},
+		{
+			name: "cluster turning NotReady after the update fails",
+			polls: []*clusterv1alpha1.Cluster{
+				newReadyCluster(metav1.ConditionTrue, before),
+				newReadyCluster(metav1.ConditionFalse, after),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset()
+			polls := 0
+			karmadaClient.PrependReactor("get", "clusters", func(clienttesting.Action) (bool, runtime.Object, error) {
+				cluster := tt.polls[min(polls, len(tt.polls)-1)]
+				polls++
+				return true, cluster.DeepCopy(), nil
+			})
+
+			err := waitForReadyWithNewCredentials(context.TODO(), karmadaClient, "member1", updatedAt, 100*time.Millisecond)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("waitForReadyWithNewCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (polls < tt.minPolls || (tt.maxPolls > 0 && polls > tt.maxPolls)) {
+				t.Errorf("cluster looked up %d times, want between %d and %d", polls, tt.minPolls, tt.maxPolls)
+			}
+		})
+	}
+}
+
+func TestRotateCredentialsSteps(t *testing.T) {
+	if steps := rotateCredentialsSteps(&rotateCredentialsOption{}); steps[len(steps)-1] != credentialsStepWait {
+		t.Errorf("rotateCredentialsSteps() = %v, want no revoke step without regenerateToken", steps)
+	}
+	if steps := rotateCredentialsSteps(&rotateCredentialsOption{regenerateToken: true}); steps[len(steps)-1] != credentialsStepRevoke {
+		t.Errorf("rotateCredentialsSteps() = %v, want the revoke step last", steps)
+	}
+}
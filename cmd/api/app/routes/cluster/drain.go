@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+const (
+	// CordonTaintKey is the key of the NoSchedule taint the dashboard puts on a cordoned cluster.
+	CordonTaintKey = "dashboard.karmada.io/cordon"
+	// DrainTaintKey is the key of the NoExecute taint the dashboard puts on a cluster to evict the
+	// workloads scheduled to it.
+	DrainTaintKey = "dashboard.karmada.io/drain"
+	// TaintsBeforeMaintenanceAnnotation keeps the taints of a cluster from before it was first
+	// cordoned or drained, uncordon restores them.
+	TaintsBeforeMaintenanceAnnotation = "dashboard.karmada.io/taints-before-maintenance"
+)
+
+// Workload states reported by the drain progress.
+const (
+	// WorkloadScheduled means replicas are still scheduled to the cluster.
+	WorkloadScheduled = "Scheduled"
+	// WorkloadEvicting means the cluster was removed from the scheduling result but the graceful
+	// eviction task has not finished yet.
+	WorkloadEvicting = "Evicting"
+)
+
+// DrainWorkload is a workload that is still on a cluster being drained.
+type DrainWorkload struct {
+	Kind      types.ResourceKind           `json:"kind"`
+	Namespace string                       `json:"namespace,omitempty"`
+	Name      string                       `json:"name"`
+	Resource  workv1alpha2.ObjectReference `json:"resource"`
+	Replicas  int32                        `json:"replicas"`
+	State     string                       `json:"state"`
+	// EvictionReason and EvictionProducer describe the graceful eviction task of an evicting workload.
+	EvictionReason   string       `json:"evictionReason,omitempty"`
+	EvictionProducer string       `json:"evictionProducer,omitempty"`
+	EvictionTime     *metav1.Time `json:"evictionTime,omitempty"`
+}
+
+// DrainProgress lists the workloads still on a cluster.
+type DrainProgress struct {
+	Cluster   string          `json:"cluster"`
+	Cordoned  bool            `json:"cordoned"`
+	Draining  bool            `json:"draining"`
+	Workloads []DrainWorkload `json:"workloads"`
+}
+
+// ensureClusterTaint adds the taint to the cluster unless a taint with the same key and effect
+// exists. The taints from before the first maintenance taint are kept so that uncordon can restore them.
+func ensureClusterTaint(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string, taint corev1.Taint) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, t := range cluster.Spec.Taints {
+			if t.MatchTaint(&taint) {
+				return nil
+			}
+		}
+		if _, ok := cluster.Annotations[TaintsBeforeMaintenanceAnnotation]; !ok {
+			previous, err := json.Marshal(cluster.Spec.Taints)
+			if err != nil {
+				return err
+			}
+			if cluster.Annotations == nil {
+				cluster.Annotations = map[string]string{}
+			}
+			cluster.Annotations[TaintsBeforeMaintenanceAnnotation] = string(previous)
+		}
+		now := metav1.Now()
+		taint.TimeAdded = &now
+		cluster.Spec.Taints = append(cluster.Spec.Taints, taint)
+		_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// cordonCluster stops new workloads from being scheduled to the cluster.
+func cordonCluster(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) error {
+	return ensureClusterTaint(ctx, karmadaClient, clusterName, corev1.Taint{
+		Key:    CordonTaintKey,
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
+
+// uncordonCluster restores the taints the cluster had before it was cordoned or drained. Without
+// the saved taints, only the cordon and drain taints are removed.
+func uncordonCluster(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if previous, ok := cluster.Annotations[TaintsBeforeMaintenanceAnnotation]; ok {
+			var taints []corev1.Taint
+			if err = json.Unmarshal([]byte(previous), &taints); err != nil {
+				return fmt.Errorf("failed to parse annotation %s of cluster %s: %w", TaintsBeforeMaintenanceAnnotation, clusterName, err)
+			}
+			cluster.Spec.Taints = taints
+			delete(cluster.Annotations, TaintsBeforeMaintenanceAnnotation)
+		} else {
+			taints := make([]corev1.Taint, 0, len(cluster.Spec.Taints))
+			for _, t := range cluster.Spec.Taints {
+				if t.Key != CordonTaintKey && t.Key != DrainTaintKey {
+					taints = append(taints, t)
+				}
+			}
+			cluster.Spec.Taints = taints
+		}
+		_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// clusterWorkloads returns the ResourceBindings and ClusterResourceBindings that still schedule
+// replicas to the cluster or have a graceful eviction task from it.
+func clusterWorkloads(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) ([]DrainWorkload, error) {
+	workloads := make([]DrainWorkload, 0)
+	rbList, err := karmadaClient.WorkV1alpha2().ResourceBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range rbList.Items {
+		rb := &rbList.Items[i]
+		if workload, ok := toDrainWorkload(&rb.Spec, clusterName); ok {
+			workload.Kind = types.ResourceKindResourceBinding
+			workload.Namespace = rb.Namespace
+			workload.Name = rb.Name
+			workloads = append(workloads, workload)
+		}
+	}
+	crbList, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range crbList.Items {
+		crb := &crbList.Items[i]
+		if workload, ok := toDrainWorkload(&crb.Spec, clusterName); ok {
+			workload.Kind = types.ResourceKindClusterResourceBinding
+			workload.Name = crb.Name
+			workloads = append(workloads, workload)
+		}
+	}
+	return workloads, nil
+}
+
+func toDrainWorkload(spec *workv1alpha2.ResourceBindingSpec, clusterName string) (DrainWorkload, bool) {
+	workload := DrainWorkload{Resource: spec.Resource}
+	if spec.TargetContains(clusterName) {
+		workload.State = WorkloadScheduled
+		workload.Replicas = spec.AssignedReplicasForCluster(clusterName)
+		return workload, true
+	}
+	for _, task := range spec.GracefulEvictionTasks {
+		if task.FromCluster != clusterName {
+			continue
+		}
+		workload.State = WorkloadEvicting
+		if task.Replicas != nil {
+			workload.Replicas = *task.Replicas
+		}
+		workload.EvictionReason = task.Reason
+		workload.EvictionProducer = task.Producer
+		workload.EvictionTime = task.CreationTimestamp
+		return workload, true
+	}
+	return workload, false
+}
+
+// getDrainProgress reports the maintenance taints of the cluster and the workloads still on it.
+func getDrainProgress(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) (*DrainProgress, error) {
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := clusterWorkloads(ctx, karmadaClient, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	return &DrainProgress{
+		Cluster:   clusterName,
+		Cordoned:  hasTaint(cluster, CordonTaintKey, corev1.TaintEffectNoSchedule),
+		Draining:  hasTaint(cluster, DrainTaintKey, corev1.TaintEffectNoExecute),
+		Workloads: workloads,
+	}, nil
+}
+
+func hasTaint(cluster *clusterv1alpha1.Cluster, key string, effect corev1.TaintEffect) bool {
+	for _, t := range cluster.Spec.Taints {
+		if t.Key == key && t.Effect == effect {
+			return true
+		}
+	}
+	return false
+}
+
+// drainCluster taints the cluster with the drain taint and waits until no workload is scheduled to
+// it and every graceful eviction task from it has finished. When the timeout expires, the workloads
+// still on the cluster are returned together with the error.
+func drainCluster(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string, timeout time.Duration) ([]DrainWorkload, error) {
+	if err := ensureClusterTaint(ctx, karmadaClient, clusterName, corev1.Taint{
+		Key:    DrainTaintKey,
+		Effect: corev1.TaintEffectNoExecute,
+	}); err != nil {
+		return nil, err
+	}
+	return waitForDrain(ctx, karmadaClient, clusterName, timeout)
+}
+
+func waitForDrain(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string, timeout time.Duration) ([]DrainWorkload, error) {
+	var remaining []DrainWorkload
+	err := wait.PollUntilContextTimeout(ctx, 2*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		workloads, err := clusterWorkloads(ctx, karmadaClient, clusterName)
+		if err != nil {
+			return false, err
+		}
+		remaining = workloads
+		klog.V(4).InfoS("Waiting for cluster to be drained", "cluster", clusterName, "workloads", len(workloads))
+		return len(workloads) == 0, nil
+	})
+	if err != nil {
+		return remaining, fmt.Errorf("cluster %s still has %d workload(s) on it: %w", clusterName, len(remaining), err)
+	}
+	return nil, nil
+}
+
+// workloadNames returns namespace/name of the workloads for messages.
+func workloadNames(workloads []DrainWorkload) []string {
+	names := make([]string, 0, len(workloads))
+	for _, w := range workloads {
+		if w.Namespace != "" {
+			names = append(names, w.Namespace+"/"+w.Name)
+		} else {
+			names = append(names, w.Name)
+		}
+	}
+	return names
+}
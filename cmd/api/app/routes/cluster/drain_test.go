@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+var (
+	cordonTaint = corev1.Taint{Key: CordonTaintKey, Effect: corev1.TaintEffectNoSchedule}
+	drainTaint  = corev1.Taint{Key: DrainTaintKey, Effect: corev1.TaintEffectNoExecute}
+	userTaint   = corev1.Taint{Key: "example.com/maintenance", Value: "true", Effect: corev1.TaintEffectNoSchedule}
+)
+
+func newTaintedCluster(annotations map[string]string, taints ...corev1.Taint) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1", Annotations: annotations},
+		Spec:       clusterv1alpha1.ClusterSpec{Taints: taints},
+	}
+}
+
+func taintKeys(taints []corev1.Taint) []string {
+	keys := make([]string, 0, len(taints))
+	for _, t := range taints {
+		keys = append(keys, t.Key)
+	}
+	return keys
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestEnsureClusterTaint(t *testing.T) {
+	savedUserTaint, _ := json.Marshal([]corev1.Taint{userTaint})
+	tests := []struct {
+		name    string
+		cluster *clusterv1alpha1.Cluster
+		taint   corev1.Taint
+		// wantTaints are the keys of the taints after the call
+		wantTaints []string
+		// wantSaved is the annotation holding the taints from before the maintenance
+		wantSaved string
+	}{
+		{
+			name:       "first maintenance taint saves the previous taints",
+			cluster:    newTaintedCluster(nil, userTaint),
+			taint:      cordonTaint,
+			wantTaints: []string{userTaint.Key, CordonTaintKey},
+			wantSaved:  string(savedUserTaint),
+		},
+		{
+			name:       "cluster without taints saves an empty list",
+			cluster:    newTaintedCluster(nil),
+			taint:      drainTaint,
+			wantTaints: []string{DrainTaintKey},
+			wantSaved:  "null",
+		},
+		{
+			name: "later maintenance taint keeps the saved taints",
+			cluster: newTaintedCluster(map[string]string{TaintsBeforeMaintenanceAnnotation: string(savedUserTaint)},
+				userTaint, cordonTaint),
+			taint:      drainTaint,
+			wantTaints: []string{userTaint.Key, CordonTaintKey, DrainTaintKey},
+			wantSaved:  string(savedUserTaint),
+		},
+		{
+			name: "existing taint is not added twice",
+			cluster: newTaintedCluster(map[string]string{TaintsBeforeMaintenanceAnnotation: "null"},
+				cordonTaint),
+			taint:      cordonTaint,
+			wantTaints: []string{CordonTaintKey},
+			wantSaved:  "null",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset(tt.cluster)
+			if err := ensureClusterTaint(context.TODO(), karmadaClient, "member1", tt.taint); err != nil {
+				t.Fatalf("ensureClusterTaint() error = %v", err)
+			}
+			cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := taintKeys(cluster.Spec.Taints); !equalKeys(got, tt.wantTaints) {
+				t.Errorf("taints = %v, want %v", got, tt.wantTaints)
+			}
+			if got := cluster.Annotations[TaintsBeforeMaintenanceAnnotation]; got != tt.wantSaved {
+				t.Errorf("saved taints = %q, want %q", got, tt.wantSaved)
+			}
+		})
+	}
+}
+
+func TestUncordonCluster(t *testing.T) {
+	savedUserTaint, _ := json.Marshal([]corev1.Taint{userTaint})
+	tests := []struct {
+		name       string
+		cluster    *clusterv1alpha1.Cluster
+		wantTaints []string
+		wantErr    bool
+	}{
+		{
+			name: "saved taints are restored",
+			cluster: newTaintedCluster(map[string]string{TaintsBeforeMaintenanceAnnotation: string(savedUserTaint)},
+				userTaint, cordonTaint, drainTaint),
+			wantTaints: []string{userTaint.Key},
+		},
+		{
+			name:       "without saved taints only the maintenance taints are removed",
+			cluster:    newTaintedCluster(nil, cordonTaint, userTaint, drainTaint),
+			wantTaints: []string{userTaint.Key},
+		},
+		{
+			name:       "malformed saved taints are reported",
+			cluster:    newTaintedCluster(map[string]string{TaintsBeforeMaintenanceAnnotation: "{"}, cordonTaint),
+			wantTaints: []string{CordonTaintKey},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset(tt.cluster)
+			err := uncordonCluster(context.TODO(), karmadaClient, "member1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("uncordonCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := taintKeys(cluster.Spec.Taints); !equalKeys(got, tt.wantTaints) {
+				t.Errorf("taints = %v, want %v", got, tt.wantTaints)
+			}
+			if _, saved := cluster.Annotations[TaintsBeforeMaintenanceAnnotation]; saved != tt.wantErr {
+				t.Errorf("saved taints annotation kept = %v, want %v", saved, tt.wantErr)
+			}
+		})
+	}
+}
+
+func newBinding(namespace, name string, clusters ...workv1alpha2.TargetCluster) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: namespace, Name: name},
+			Clusters: clusters,
+		},
+	}
+}
+
+func TestClusterWorkloads(t *testing.T) {
+	replicas := int32(2)
+	evicting := newBinding("default", "evicting", workv1alpha2.TargetCluster{Name: "member2", Replicas: 2})
+	evicting.Spec.GracefulEvictionTasks = []workv1alpha2.GracefulEvictionTask{{
+		FromCluster: "member1",
+		Replicas:    &replicas,
+		Reason:      "TaintUntolerated",
+		Producer:    "TaintManager",
+	}}
+	crb := &workv1alpha2.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "reader"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "reader"},
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+		},
+	}
+	karmadaClient := karmadafake.NewSimpleClientset(
+		newBinding("default", "scheduled", workv1alpha2.TargetCluster{Name: "member1", Replicas: 3}),
+		evicting,
+		newBinding("default", "elsewhere", workv1alpha2.TargetCluster{Name: "member2", Replicas: 1}),
+		crb,
+	)
+
+	workloads, err := clusterWorkloads(context.TODO(), karmadaClient, "member1")
+	if err != nil {
+		t.Fatalf("clusterWorkloads() error = %v", err)
+	}
+	want := map[string]DrainWorkload{
+		"evicting":  {Kind: types.ResourceKindResourceBinding, Namespace: "default", Replicas: 2, State: WorkloadEvicting, EvictionReason: "TaintUntolerated", EvictionProducer: "TaintManager"},
+		"scheduled": {Kind: types.ResourceKindResourceBinding, Namespace: "default", Replicas: 3, State: WorkloadScheduled},
+		"reader":    {Kind: types.ResourceKindClusterResourceBinding, State: WorkloadScheduled},
+	}
+	if len(workloads) != len(want) {
+		t.Fatalf("clusterWorkloads() = %+v, want %d workloads", workloads, len(want))
+	}
+	for _, got := range workloads {
+		w, ok := want[got.Name]
+		if !ok {
+			t.Errorf("unexpected workload %s", got.Name)
+			continue
+		}
+		if got.Kind != w.Kind || got.Namespace != w.Namespace || got.Replicas != w.Replicas || got.State != w.State ||
+			got.EvictionReason != w.EvictionReason || got.EvictionProducer != w.EvictionProducer {
+			t.Errorf("workload %s = %+v, want %+v", got.Name, got, w)
+		}
+	}
+}
+
+func TestWaitForDrain(t *testing.T) {
+	tests := []struct {
+		name          string
+		bindings      []*workv1alpha2.ResourceBinding
+		wantErr       bool
+		wantRemaining []string
+	}{
+		{
+			name:     "drained cluster returns at once",
+			bindings: []*workv1alpha2.ResourceBinding{newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member2"})},
+		},
+		{
+			name:          "timeout returns the remaining workloads",
+			bindings:      []*workv1alpha2.ResourceBinding{newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member1"})},
+			wantErr:       true,
+			wantRemaining: []string{"default/nginx"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset()
+			for _, rb := range tt.bindings {
+				if err := karmadaClient.Tracker().Add(rb); err != nil {
+					t.Fatal(err)
+				}
+			}
+			remaining, err := waitForDrain(context.TODO(), karmadaClient, "member1", 50*time.Millisecond)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("waitForDrain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := workloadNames(remaining); !equalKeys(got, tt.wantRemaining) {
+				t.Errorf("remaining workloads = %v, want %v", got, tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+const (
+	drillStepEvict = "Evict workloads from cluster"
+	drillStepWait  = "Wait for workloads to become healthy elsewhere"
+
+	// FailoverDrillProducer is the producer of the graceful eviction tasks a failover drill creates.
+	FailoverDrillProducer = "KarmadaDashboard"
+	// FailoverDrillReason is the reason of the graceful eviction tasks a failover drill creates.
+	FailoverDrillReason = "FailoverDrill"
+)
+
+// The states of a workload during a failover drill.
+const (
+	DrillWorkloadRecovering = "Recovering"
+	DrillWorkloadRecovered  = "Recovered"
+	DrillWorkloadTimedOut   = "TimedOut"
+	DrillWorkloadDeleted    = "Deleted"
+)
+
+var failoverDrillSteps = []string{drillStepEvict, drillStepWait}
+
+// DrillWorkload is the outcome of a failover drill for a single workload.
+type DrillWorkload struct {
+	Namespace string                       `json:"namespace"`
+	Name      string                       `json:"name"`
+	Resource  workv1alpha2.ObjectReference `json:"resource"`
+	// Replicas is the number of replicas evicted from the drilled cluster.
+	Replicas     int32        `json:"replicas"`
+	Status       string       `json:"status"`
+	EvictionTime metav1.Time  `json:"evictionTime"`
+	RecoveryTime *metav1.Time `json:"recoveryTime,omitempty"`
+	// RecoverySeconds is how long the workload took to become healthy on the other clusters.
+	RecoverySeconds float64 `json:"recoverySeconds,omitempty"`
+	// TargetClusters are the clusters the workload runs on after the drill.
+	TargetClusters []string `json:"targetClusters,omitempty"`
+}
+
+// FailoverDrillReport is the result of a failover drill operation.
+type FailoverDrillReport struct {
+	Cluster    string          `json:"cluster"`
+	Namespaces []string        `json:"namespaces"`
+	Workloads  []DrillWorkload `json:"workloads"`
+}
+
+type failoverDrillOption struct {
+	karmadaClient karmadaclientset.Interface
+	clusterName   string
+	namespaces    []string
+	timeout       time.Duration
+}
+
+// runFailoverDrill evicts the ResourceBindings of the namespaces from the cluster the way the taint
+// manager does on a cluster failure, and measures how long each workload takes to become healthy on
+// the clusters it is rescheduled to. The other namespaces are not affected.
+func runFailoverDrill(ctx context.Context, tracker *operation.Tracker, opts *failoverDrillOption) error {
+	report := &FailoverDrillReport{
+		Cluster:    opts.clusterName,
+		Namespaces: opts.namespaces,
+		Workloads:  make([]DrillWorkload, 0),
+	}
+	publish := func() {
+		snapshot := *report
+		snapshot.Workloads = append([]DrillWorkload(nil), report.Workloads...)
+		tracker.SetResult(&snapshot)
+	}
+
+	err := tracker.Step(drillStepEvict, func() error {
+		for _, namespace := range opts.namespaces {
+			rbList, err := opts.karmadaClient.WorkV1alpha2().ResourceBindings(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			for i := range rbList.Items {
+				rb := &rbList.Items[i]
+				if !rb.Spec.TargetContains(opts.clusterName) {
+					continue
+				}
+				replicas := rb.Spec.AssignedReplicasForCluster(opts.clusterName)
+				if err = evictForDrill(ctx, opts.karmadaClient, rb.Namespace, rb.Name, opts.clusterName); err != nil {
+					return fmt.Errorf("failed to evict ResourceBinding %s/%s: %w", rb.Namespace, rb.Name, err)
+				}
+				report.Workloads = append(report.Workloads, DrillWorkload{
+					Namespace:    rb.Namespace,
+					Name:         rb.Name,
+					Resource:     rb.Spec.Resource,
+					Replicas:     replicas,
+					Status:       DrillWorkloadRecovering,
+					EvictionTime: metav1.Now(),
+				})
+				publish()
+			}
+		}
+		if len(report.Workloads) == 0 {
+			tracker.Note(drillStepEvict, "no workload of the namespaces is scheduled to the cluster")
+		}
+		return nil
+	})
+	publish()
+	if err != nil {
+		return err
+	}
+
+	return tracker.Step(drillStepWait, func() error {
+		err := wait.PollUntilContextTimeout(ctx, 2*time.Second, opts.timeout, true, func(ctx context.Context) (bool, error) {
+			done := true
+			for i := range report.Workloads {
+				workload := &report.Workloads[i]
+				if workload.Status != DrillWorkloadRecovering {
+					continue
+				}
+				rb, err := opts.karmadaClient.WorkV1alpha2().ResourceBindings(workload.Namespace).Get(ctx, workload.Name, metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					workload.Status = DrillWorkloadDeleted
+					continue
+				}
+				if err != nil {
+					return false, err
+				}
+				if !recoveredElsewhere(rb, opts.clusterName) {
+					done = false
+					continue
+				}
+				now := metav1.Now()
+				workload.Status = DrillWorkloadRecovered
+				workload.RecoveryTime = &now
+				workload.RecoverySeconds = now.Sub(workload.EvictionTime.Time).Seconds()
+				for _, target := range rb.Spec.Clusters {
+					workload.TargetClusters = append(workload.TargetClusters, target.Name)
+				}
+			}
+			publish()
+			return done, nil
+		})
+		if err == nil {
+			return nil
+		}
+		timedOut := 0
+		for i := range report.Workloads {
+			if report.Workloads[i].Status == DrillWorkloadRecovering {
+				report.Workloads[i].Status = DrillWorkloadTimedOut
+				timedOut++
+			}
+		}
+		publish()
+		if timedOut == 0 {
+			return err
+		}
+		return fmt.Errorf("%d workload(s) did not become healthy on other clusters within %s", timedOut, opts.timeout)
+	})
+}
+
+// evictForDrill moves the cluster from the scheduling result of the binding into a graceful
+// eviction task, the scheduler then places the evicted replicas on the other clusters.
+func evictForDrill(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, name, clusterName string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		rb, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		rb.Spec.GracefulEvictCluster(clusterName, workv1alpha2.NewTaskOptions(
+			workv1alpha2.WithPurgeMode(policyv1alpha1.PurgeModeGracefully),
+			workv1alpha2.WithProducer(FailoverDrillProducer),
+			workv1alpha2.WithReason(FailoverDrillReason),
+			workv1alpha2.WithMessage("Evicted by a failover drill from the karmada dashboard")))
+		_, err = karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Update(ctx, rb, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// recoveredElsewhere tells whether the binding runs on other clusters only and is applied and not
+// unhealthy on each of them.
+func recoveredElsewhere(rb *workv1alpha2.ResourceBinding, clusterName string) bool {
+	if rb.Spec.TargetContains(clusterName) || len(rb.Spec.Clusters) == 0 {
+		return false
+	}
+	statuses := make(map[string]workv1alpha2.AggregatedStatusItem, len(rb.Status.AggregatedStatus))
+	for _, item := range rb.Status.AggregatedStatus {
+		statuses[item.ClusterName] = item
+	}
+	for _, target := range rb.Spec.Clusters {
+		item, ok := statuses[target.Name]
+		if !ok || !item.Applied || item.Health == workv1alpha2.ResourceUnhealthy {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+func withStatus(rb *workv1alpha2.ResourceBinding, items ...workv1alpha2.AggregatedStatusItem) *workv1alpha2.ResourceBinding {
+	rb.Status.AggregatedStatus = items
+	return rb
+}
+
+func TestRecoveredElsewhere(t *testing.T) {
+	applied := func(cluster string, health workv1alpha2.ResourceHealth) workv1alpha2.AggregatedStatusItem {
+		return workv1alpha2.AggregatedStatusItem{ClusterName: cluster, Applied: true, Health: health}
+	}
+	tests := []struct {
+		name string
+		rb   *workv1alpha2.ResourceBinding
+		want bool
+	}{
+		{
+			name: "healthy on the other clusters",
+			rb: withStatus(newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member2"}, workv1alpha2.TargetCluster{Name: "member3"}),
+				applied("member2", workv1alpha2.ResourceHealthy), applied("member3", workv1alpha2.ResourceUnknown)),
+			want: true,
+		},
+		{
+			name: "still scheduled to the drilled cluster",
+			rb: withStatus(newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member1"}, workv1alpha2.TargetCluster{Name: "member2"}),
+				applied("member1", workv1alpha2.ResourceHealthy), applied("member2", workv1alpha2.ResourceHealthy)),
+		},
+		{
+			name: "not rescheduled yet",
+			rb:   newBinding("default", "nginx"),
+		},
+		{
+			name: "not applied on a new cluster",
+			rb: withStatus(newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member2"}),
+				workv1alpha2.AggregatedStatusItem{ClusterName: "member2", AppliedMessage: "conflict"}),
+		},
+		{
+			name: "unhealthy on a new cluster",
+			rb: withStatus(newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member2"}),
+				applied("member2", workv1alpha2.ResourceUnhealthy)),
+		},
+		{
+			name: "no status from a new cluster",
+			rb:   newBinding("default", "nginx", workv1alpha2.TargetCluster{Name: "member2"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := recoveredElsewhere(tt.rb, "member1"); got != tt.want {
+				t.Errorf("recoveredElsewhere() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunFailoverDrill(t *testing.T) {
+	healthy := workv1alpha2.AggregatedStatusItem{ClusterName: "member2", Applied: true, Health: workv1alpha2.ResourceHealthy}
+	tests := []struct {
+		name     string
+		bindings []*workv1alpha2.ResourceBinding
+		wantErr  bool
+		// wantStatus is the drill status of each workload of the report, by name
+		wantStatus map[string]string
+	}{
+		{
+			name: "workloads recover on the other clusters",
+			bindings: []*workv1alpha2.ResourceBinding{
+				withStatus(newBinding("default", "spread",
+					workv1alpha2.TargetCluster{Name: "member1", Replicas: 2}, workv1alpha2.TargetCluster{Name: "member2", Replicas: 2}), healthy),
+				newBinding("default", "elsewhere", workv1alpha2.TargetCluster{Name: "member2"}),
+			},
+			wantStatus: map[string]string{"spread": DrillWorkloadRecovered},
+		},
+		{
+			name: "workload without another cluster times out",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("default", "pinned", workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}),
+			},
+			wantErr:    true,
+			wantStatus: map[string]string{"pinned": DrillWorkloadTimedOut},
+		},
+		{
+			name: "other namespaces are not evicted",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("other", "nginx", workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}),
+			},
+			wantStatus: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset()
+			for _, rb := range tt.bindings {
+				if err := karmadaClient.Tracker().Add(rb); err != nil {
+					t.Fatal(err)
+				}
+			}
+			tracker := operation.New(failoverDrillOperation, "member1", failoverDrillSteps...)
+			err := runFailoverDrill(context.TODO(), tracker, &failoverDrillOption{
+				karmadaClient: karmadaClient,
+				clusterName:   "member1",
+				namespaces:    []string{"default"},
+				timeout:       50 * time.Millisecond,
+			})
+			tracker.Finish(err)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runFailoverDrill() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			report, ok := tracker.Snapshot().Result.(*FailoverDrillReport)
+			if !ok {
+				t.Fatalf("expected a FailoverDrillReport result, got %T", tracker.Snapshot().Result)
+			}
+			if len(report.Workloads) != len(tt.wantStatus) {
+				t.Fatalf("report workloads = %+v, want %v", report.Workloads, tt.wantStatus)
+			}
+			for _, workload := range report.Workloads {
+				if workload.Status != tt.wantStatus[workload.Name] {
+					t.Errorf("workload %s status = %s, want %s", workload.Name, workload.Status, tt.wantStatus[workload.Name])
+				}
+			}
+
+			// the drilled bindings are evicted from the cluster by a drill task, the others are untouched
+			for _, rb := range tt.bindings {
+				current, err := karmadaClient.WorkV1alpha2().ResourceBindings(rb.Namespace).Get(context.TODO(), rb.Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, drilled := tt.wantStatus[rb.Name]
+				if got, want := current.Spec.TargetContains("member1"), rb.Spec.TargetContains("member1") && !drilled; got != want {
+					t.Errorf("binding %s/%s scheduled to member1 = %v, want %v", rb.Namespace, rb.Name, got, want)
+				}
+				tasks := current.Spec.GracefulEvictionTasks
+				if !drilled {
+					if len(tasks) != 0 {
+						t.Errorf("binding %s/%s eviction tasks = %+v, want none", rb.Namespace, rb.Name, tasks)
+					}
+					continue
+				}
+				if len(tasks) != 1 || tasks[0].Producer != FailoverDrillProducer || tasks[0].Reason != FailoverDrillReason {
+					t.Errorf("binding %s/%s eviction tasks = %+v, want one drill task", rb.Namespace, rb.Name, tasks)
+				}
+			}
+		})
+	}
+}
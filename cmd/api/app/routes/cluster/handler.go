@@ -33,9 +33,38 @@ import (
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/clusterhistory"
+	"github.com/karmada-io/dashboard/pkg/operation"
 	"github.com/karmada-io/dashboard/pkg/resource/cluster"
 )
 
+const (
+	// joinClusterOperation is the operation type of a cluster join.
+	joinClusterOperation = "JoinCluster"
+	// unjoinClusterOperation is the operation type of a cluster unjoin.
+	unjoinClusterOperation = "UnjoinCluster"
+	// defaultAgentNamespace is where the karmada-agent of a pull mode cluster runs by default.
+	defaultAgentNamespace = "karmada-system"
+	// defaultUnjoinTimeout bounds each wait of an unjoin unless the request sets a timeout.
+	defaultUnjoinTimeout = 60 * time.Second
+	// drainClusterOperation is the operation type of a cluster drain.
+	drainClusterOperation = "DrainCluster"
+	// failoverDrillOperation is the operation type of a failover drill.
+	failoverDrillOperation = "FailoverDrill"
+	// defaultMaintenanceTimeout bounds the wait of a drain or a failover drill unless the request sets a timeout.
+	defaultMaintenanceTimeout = 10 * time.Minute
+
+	// rotateCredentialsOperation is the operation type of a credential rotation.
+	rotateCredentialsOperation = "RotateClusterCredentials"
+	// defaultRotateCredentialsTimeout bounds the wait for the cluster to become Ready after a rotation.
+	defaultRotateCredentialsTimeout = 2 * time.Minute
+	// defaultCredentialExpiryWarningDays is how many days ahead of expiry credentials are reported as expiring.
+	defaultCredentialExpiryWarningDays = 30
+
+	drainStepTaint = "Taint cluster with NoExecute"
+	drainStepWait  = "Wait for workloads to be evicted"
+)
+
 func handleGetClusterList(c *gin.Context) {
 	karmadaClient, err := router.GetKarmadaClientFromContext(c)
 	if err != nil {
@@ -68,6 +97,26 @@ func handleGetClusterDetail(c *gin.Context) {
 	common.Success(c, result)
 }
 
+func handleGetClusterKindSupport(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kindRequest := new(v1.ClusterKindSupportRequest)
+	if err = c.ShouldBindQuery(kindRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := cluster.GetKindSupport(karmadaClient, c.Param("name"), kindRequest.APIVersion, kindRequest.Kind)
+	if err != nil {
+		klog.ErrorS(err, "GetKindSupport failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
 func handlePostCluster(c *gin.Context) {
 	clusterRequest := new(v1.PostClusterRequest)
 	if err := c.ShouldBind(clusterRequest); err != nil {
@@ -87,7 +136,25 @@ func handlePostCluster(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if clusterRequest.Preflight {
+		preflight := runPreflight(c, &preflightOption{
+			karmadaClient:           karmadaClient,
+			memberClusterKubeConfig: clusterRequest.MemberClusterKubeConfig,
+			memberClusterName:       clusterRequest.MemberClusterName,
+			memberClusterNamespace:  clusterRequest.MemberClusterNamespace,
+			syncMode:                clusterRequest.SyncMode,
+		})
+		if err = preflight.failedChecks(); err != nil {
+			klog.ErrorS(err, "Cluster preflight failed", "cluster", clusterRequest.MemberClusterName)
+			common.Fail(c, err)
+			return
+		}
+	}
 
+	var (
+		steps []string
+		join  func(ctx context.Context, tracker *operation.Tracker) error
+	)
 	switch clusterRequest.SyncMode {
 	case v1alpha1.Pull:
 		memberClusterClient, err := client.KubeClientSetFromKubeConfig(clusterRequest.MemberClusterKubeConfig)
@@ -110,12 +177,9 @@ func handlePostCluster(c *gin.Context) {
 			memberClusterName:      clusterRequest.MemberClusterName,
 			memberClusterEndpoint:  clusterRequest.MemberClusterEndpoint,
 		}
-		if err = accessClusterInPullMode(opts); err != nil {
-			klog.ErrorS(err, "accessClusterInPullMode failed")
-			common.Fail(c, err)
-		} else {
-			klog.Infof("accessClusterInPullMode success")
-			common.Success(c, "ok")
+		steps = pullModeJoinSteps
+		join = func(ctx context.Context, tracker *operation.Tracker) error {
+			return accessClusterInPullMode(ctx, tracker, opts)
 		}
 	case v1alpha1.Push:
 		memberClusterRestConfig, err := client.LoadRestConfigFromKubeConfig(clusterRequest.MemberClusterKubeConfig)
@@ -136,17 +200,334 @@ func handlePostCluster(c *gin.Context) {
 			karmadaRestConfig:       restConfig,
 			memberClusterRestConfig: memberClusterRestConfig,
 		}
-		if err := accessClusterInPushMode(opts); err != nil {
-			klog.ErrorS(err, "accessClusterInPushMode failed")
-			common.Fail(c, err)
-			return
+		steps = pushModeJoinSteps
+		join = func(ctx context.Context, tracker *operation.Tracker) error {
+			return accessClusterInPushMode(ctx, tracker, opts)
 		}
-		klog.Infof("accessClusterInPushMode success")
-		common.Success(c, "ok")
 	default:
 		klog.Errorf("Unknown sync mode %s", clusterRequest.SyncMode)
 		common.Fail(c, fmt.Errorf("unknown sync mode %s", clusterRequest.SyncMode))
+		return
+	}
+
+	tracker := operation.New(joinClusterOperation, clusterRequest.MemberClusterName, steps...)
+	runOperation(c, tracker, clusterRequest.Async, func(ctx context.Context) error {
+		return join(ctx, tracker)
+	})
+}
+
+func handleUnjoinCluster(c *gin.Context) {
+	name := c.Param("name")
+	unjoinRequest := new(v1.UnjoinClusterRequest)
+	if err := c.ShouldBind(unjoinRequest); err != nil {
+		klog.ErrorS(err, "Could not read cluster unjoin request")
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts := &unjoinOption{
+		karmadaClient:      karmadaClient,
+		controlPlaneClient: kubeClient,
+		clusterName:        name,
+		agentNamespace:     unjoinRequest.MemberClusterNamespace,
+		drain:              unjoinRequest.Drain,
+		force:              unjoinRequest.Force,
+		timeout:            time.Duration(unjoinRequest.TimeoutSeconds) * time.Second,
+	}
+	if opts.agentNamespace == "" {
+		opts.agentNamespace = defaultAgentNamespace
+	}
+	if opts.timeout <= 0 {
+		opts.timeout = defaultUnjoinTimeout
+	}
+	if unjoinRequest.MemberClusterKubeConfig != "" {
+		if opts.memberClusterClient, err = client.KubeClientSetFromKubeConfig(unjoinRequest.MemberClusterKubeConfig); err != nil {
+			klog.ErrorS(err, "Generate kubeclient from memberClusterKubeconfig failed")
+			common.Fail(c, err)
+			return
+		}
+	}
+
+	tracker := operation.New(unjoinClusterOperation, name, unjoinSteps(opts)...)
+	runOperation(c, tracker, unjoinRequest.Async, func(ctx context.Context) error {
+		return unjoinCluster(ctx, tracker, opts)
+	})
+}
+
+func handleRotateClusterCredentials(c *gin.Context) {
+	name := c.Param("name")
+	rotateRequest := new(v1.RotateClusterCredentialsRequest)
+	if err := c.ShouldBind(rotateRequest); err != nil {
+		klog.ErrorS(err, "Could not read cluster credentials request")
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts := &rotateCredentialsOption{
+		karmadaClient:           karmadaClient,
+		controlPlaneClient:      kubeClient,
+		clusterName:             name,
+		memberClusterKubeConfig: rotateRequest.MemberClusterKubeConfig,
+		regenerateToken:         rotateRequest.RegenerateToken,
+		timeout:                 time.Duration(rotateRequest.TimeoutSeconds) * time.Second,
+		warnBefore:              credentialExpiryWarning(rotateRequest.WarnDays),
+	}
+	if opts.timeout <= 0 {
+		opts.timeout = defaultRotateCredentialsTimeout
 	}
+
+	tracker := operation.New(rotateCredentialsOperation, name, rotateCredentialsSteps(opts)...)
+	runOperation(c, tracker, rotateRequest.Async, func(ctx context.Context) error {
+		return rotateClusterCredentials(ctx, tracker, opts)
+	})
+}
+
+func handleGetClusterCredentials(c *gin.Context) {
+	credentialsRequest := new(v1.ClusterCredentialsRequest)
+	if err := c.ShouldBindQuery(credentialsRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := getCredentialReport(c, karmadaClient, kubeClient, c.Param("name"), credentialExpiryWarning(credentialsRequest.WarnDays))
+	if err != nil {
+		klog.ErrorS(err, "Get cluster credentials failed", "cluster", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func credentialExpiryWarning(days int) time.Duration {
+	if days <= 0 {
+		days = defaultCredentialExpiryWarningDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func handleCordonCluster(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = cordonCluster(c, karmadaClient, c.Param("name")); err != nil {
+		klog.ErrorS(err, "Cordon cluster failed", "cluster", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleUncordonCluster(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = uncordonCluster(c, karmadaClient, c.Param("name")); err != nil {
+		klog.ErrorS(err, "Uncordon cluster failed", "cluster", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDrainCluster(c *gin.Context) {
+	name := c.Param("name")
+	drainRequest := new(v1.DrainClusterRequest)
+	// the body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBind(drainRequest); err != nil {
+			klog.ErrorS(err, "Could not read cluster drain request")
+			common.Fail(c, err)
+			return
+		}
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if _, err = karmadaClient.ClusterV1alpha1().Clusters().Get(c, name, metav1.GetOptions{}); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	timeout := time.Duration(drainRequest.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultMaintenanceTimeout
+	}
+
+	tracker := operation.New(drainClusterOperation, name, drainStepTaint, drainStepWait)
+	runOperation(c, tracker, drainRequest.Async, func(ctx context.Context) error {
+		err := tracker.Step(drainStepTaint, func() error {
+			return ensureClusterTaint(ctx, karmadaClient, name, corev1.Taint{
+				Key:    DrainTaintKey,
+				Effect: corev1.TaintEffectNoExecute,
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return tracker.Step(drainStepWait, func() error {
+			remaining, err := waitForDrain(ctx, karmadaClient, name, timeout)
+			if err != nil {
+				tracker.SetResult(remaining)
+			}
+			return err
+		})
+	})
+}
+
+func handleGetDrainProgress(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := getDrainProgress(c, karmadaClient, c.Param("name"))
+	if err != nil {
+		klog.ErrorS(err, "Get drain progress failed", "cluster", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetClusterHistory(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	name := c.Param("name")
+	if _, err = karmadaClient.ClusterV1alpha1().Clusters().Get(c, name, metav1.GetOptions{}); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := clusterhistory.GetHistory(c, name)
+	if err != nil {
+		klog.ErrorS(err, "Get cluster history failed", "cluster", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleFailoverDrill(c *gin.Context) {
+	name := c.Param("name")
+	drillRequest := new(v1.FailoverDrillRequest)
+	if err := c.ShouldBind(drillRequest); err != nil {
+		klog.ErrorS(err, "Could not read failover drill request")
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if _, err = karmadaClient.ClusterV1alpha1().Clusters().Get(c, name, metav1.GetOptions{}); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts := &failoverDrillOption{
+		karmadaClient: karmadaClient,
+		clusterName:   name,
+		namespaces:    drillRequest.Namespaces,
+		timeout:       time.Duration(drillRequest.TimeoutSeconds) * time.Second,
+	}
+	if opts.timeout <= 0 {
+		opts.timeout = defaultMaintenanceTimeout
+	}
+
+	tracker := operation.New(failoverDrillOperation, name, failoverDrillSteps...)
+	runOperation(c, tracker, drillRequest.Async, func(ctx context.Context) error {
+		return runFailoverDrill(ctx, tracker, opts)
+	})
+}
+
+// runOperation runs fn as the tracked operation. An async operation is returned right away and
+// runs in the background, otherwise the response waits for it and is the finished operation when
+// it produced a result, or "ok".
+func runOperation(c *gin.Context, tracker *operation.Tracker, async bool, fn func(ctx context.Context) error) {
+	run := func() error {
+		// the operation must not stop halfway when the client goes away, otherwise nothing rolls it back
+		err := fn(context.TODO())
+		tracker.Finish(err)
+		op := tracker.Snapshot()
+		if err != nil {
+			klog.ErrorS(err, "Operation failed", "operation", op.ID, "type", op.Type, "target", op.Target)
+			return err
+		}
+		klog.InfoS("Operation succeeded", "operation", op.ID, "type", op.Type, "target", op.Target)
+		return nil
+	}
+	if async {
+		go func() {
+			_ = run()
+		}()
+		common.Success(c, tracker.Snapshot())
+		return
+	}
+	if err := run(); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if op := tracker.Snapshot(); op.Result != nil {
+		common.Success(c, op)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePostClusterPreflight(c *gin.Context) {
+	preflightRequest := new(v1.PreflightClusterRequest)
+	if err := c.ShouldBind(preflightRequest); err != nil {
+		klog.ErrorS(err, "Could not read cluster preflight request")
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result := runPreflight(c, &preflightOption{
+		karmadaClient:           karmadaClient,
+		memberClusterKubeConfig: preflightRequest.MemberClusterKubeConfig,
+		memberClusterName:       preflightRequest.MemberClusterName,
+		memberClusterNamespace:  preflightRequest.MemberClusterNamespace,
+		syncMode:                preflightRequest.SyncMode,
+	})
+	common.Success(c, result)
 }
 
 func handlePutCluster(c *gin.Context) {
@@ -199,6 +580,32 @@ func handlePutCluster(c *gin.Context) {
 	common.Success(c, "ok")
 }
 
+func handleBulkUpdateClusters(c *gin.Context) {
+	bulkRequest := new(v1.BulkUpdateClustersRequest)
+	if err := c.ShouldBind(bulkRequest); err != nil {
+		klog.ErrorS(err, "Could not read bulk update clusters request")
+		common.Fail(c, err)
+		return
+	}
+	if bulkRequest.LabelSelector == "" && !bulkRequest.AllClusters {
+		common.Fail(c, fmt.Errorf("labelSelector is required, set allClusters to update every cluster"))
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := cluster.BulkUpdateClusters(c, karmadaClient, bulkRequest.LabelSelector,
+		bulkRequest.Labels, bulkRequest.Taints, bulkRequest.DryRun)
+	if err != nil {
+		klog.ErrorS(err, "Bulk update clusters failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
 func handleDeleteCluster(c *gin.Context) {
 	ctx := context.Context(c)
 	clusterRequest := new(v1.DeleteClusterRequest)
@@ -258,7 +665,19 @@ func init() {
 	r := router.V1()
 	r.GET("/cluster", handleGetClusterList)
 	r.GET("/cluster/:name", handleGetClusterDetail)
+	r.GET("/cluster/:name/kindsupport", handleGetClusterKindSupport)
 	r.POST("/cluster", handlePostCluster)
+	r.POST("/cluster/preflight", handlePostClusterPreflight)
 	r.PUT("/cluster/:name", handlePutCluster)
+	r.POST("/cluster/bulk", handleBulkUpdateClusters)
 	r.DELETE("/cluster/:name", handleDeleteCluster)
+	r.POST("/cluster/:name/unjoin", handleUnjoinCluster)
+	r.GET("/cluster/:name/credentials", handleGetClusterCredentials)
+	r.PUT("/cluster/:name/credentials", handleRotateClusterCredentials)
+	r.POST("/cluster/:name/cordon", handleCordonCluster)
+	r.POST("/cluster/:name/uncordon", handleUncordonCluster)
+	r.POST("/cluster/:name/drain", handleDrainCluster)
+	r.GET("/cluster/:name/drain", handleGetDrainProgress)
+	r.POST("/cluster/:name/drill", handleFailoverDrill)
+	r.GET("/cluster/:name/history", handleGetClusterHistory)
 }
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
+// PreflightCheckStatus is the outcome of a single preflight check.
+type PreflightCheckStatus string
+
+const (
+	// PreflightCheckPassed means the check found no problem.
+	PreflightCheckPassed PreflightCheckStatus = "Passed"
+	// PreflightCheckWarning means joining may work, but something should be looked at.
+	PreflightCheckWarning PreflightCheckStatus = "Warning"
+	// PreflightCheckFailed means joining will fail or leave the member cluster in a bad state.
+	PreflightCheckFailed PreflightCheckStatus = "Failed"
+	// PreflightCheckSkipped means the check could not run because an earlier check failed.
+	PreflightCheckSkipped PreflightCheckStatus = "Skipped"
+)
+
+const (
+	// minSupportedKubernetesVersion is the oldest member cluster version in the Karmada compatibility matrix.
+	minSupportedKubernetesVersion = "v1.16.0"
+	// maxKubernetesMinorSkew is how many minor versions a member cluster may be ahead of the karmada-apiserver.
+	maxKubernetesMinorSkew = 1
+	preflightTimeout       = 10 * time.Second
+)
+
+var (
+	// getKarmadaConfig returns the karmada-apiserver config handed to karmada-agent, it is replaced in tests.
+	getKarmadaConfig = client.GetKarmadaConfig
+	// dialEndpoint opens a connection to the karmada-apiserver endpoint, it is replaced in tests.
+	dialEndpoint = net.DialTimeout
+)
+
+// PreflightCheck is a single entry of the preflight checklist.
+type PreflightCheck struct {
+	Name    string               `json:"name"`
+	Status  PreflightCheckStatus `json:"status"`
+	Message string               `json:"message"`
+}
+
+// PreflightResult is the checklist returned by the cluster preflight.
+type PreflightResult struct {
+	// Passed is false when at least one check failed, warnings don't block joining.
+	Passed bool             `json:"passed"`
+	Checks []PreflightCheck `json:"checks"`
+}
+
+type preflightOption struct {
+	karmadaClient           karmadaclientset.Interface
+	memberClusterKubeConfig string
+	memberClusterName       string
+	memberClusterNamespace  string
+	syncMode                clusterv1alpha1.ClusterSyncMode
+}
+
+func (r *PreflightResult) add(name string, status PreflightCheckStatus, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+	if status == PreflightCheckFailed {
+		r.Passed = false
+	}
+}
+
+// failedChecks returns the failed checks as an error, nil when the preflight passed.
+func (r *PreflightResult) failedChecks() error {
+	var failed []string
+	for _, check := range r.Checks {
+		if check.Status == PreflightCheckFailed {
+			failed = append(failed, fmt.Sprintf("%s: %s", check.Name, check.Message))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("cluster preflight failed: %s", strings.Join(failed, "; "))
+}
+
+// runPreflight checks whether the member cluster can be joined, without changing the member cluster
+// or the karmada control plane.
+func runPreflight(ctx context.Context, opts *preflightOption) *PreflightResult {
+	result := &PreflightResult{Passed: true, Checks: make([]PreflightCheck, 0)}
+	if memberClient := checkMemberConnection(opts, result); memberClient != nil {
+		checkCredentials(ctx, memberClient, result)
+		checkKubernetesVersion(opts.karmadaClient, memberClient, result)
+		checkClusterName(opts, result)
+		checkClusterIdentity(ctx, opts.karmadaClient, memberClient, result)
+		checkExistingKarmada(ctx, memberClient, opts, result)
+	} else {
+		for _, name := range []string{"Credentials", "KubernetesVersion", "ClusterIdentity", "ExistingKarmada"} {
+			result.add(name, PreflightCheckSkipped, "the member cluster is not reachable")
+		}
+		checkClusterName(opts, result)
+	}
+	checkKarmadaEndpoint(opts, result)
+	return result
+}
+
+// checkMemberConnection loads the member kubeconfig and reaches the member apiserver, it returns nil
+// when either fails.
+func checkMemberConnection(opts *preflightOption, result *PreflightResult) kubeclient.Interface {
+	memberRestConfig, err := client.LoadRestConfigFromKubeConfig(opts.memberClusterKubeConfig)
+	if err != nil {
+		result.add("Kubeconfig", PreflightCheckFailed, "failed to load the member cluster kubeconfig: %v", err)
+		result.add("Reachability", PreflightCheckSkipped, "the member cluster kubeconfig is invalid")
+		return nil
+	}
+	result.add("Kubeconfig", PreflightCheckPassed, "member cluster endpoint is %s", memberRestConfig.Host)
+
+	memberRestConfig = rest.CopyConfig(memberRestConfig)
+	memberRestConfig.Timeout = preflightTimeout
+	memberClient, err := newClientForConfig(memberRestConfig)
+	if err == nil {
+		_, err = memberClient.Discovery().ServerVersion()
+	}
+	if err != nil {
+		result.add("Reachability", PreflightCheckFailed, "failed to reach %s: %v", memberRestConfig.Host, err)
+		return nil
+	}
+	result.add("Reachability", PreflightCheckPassed, "reached %s", memberRestConfig.Host)
+	return memberClient
+}
+
+// checkCredentials verifies the member credentials are accepted and allowed to manage every resource,
+// which both the push mode registration and the karmada-agent deployment need.
+func checkCredentials(ctx context.Context, memberClient kubeclient.Interface, result *PreflightResult) {
+	review, err := memberClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: "*", Group: "*", Resource: "*"},
+		},
+	}, metav1.CreateOptions{})
+	switch {
+	case apierrors.IsUnauthorized(err):
+		result.add("Credentials", PreflightCheckFailed, "the member cluster rejected the credentials: %v", err)
+	case err != nil:
+		result.add("Credentials", PreflightCheckFailed, "failed to review the access of the credentials: %v", err)
+	case !review.Status.Allowed:
+		result.add("Credentials", PreflightCheckFailed, "the credentials are not cluster-admin in the member cluster: %s", review.Status.Reason)
+	default:
+		result.add("Credentials", PreflightCheckPassed, "the credentials are cluster-admin in the member cluster")
+	}
+}
+
+func checkKubernetesVersion(karmadaClient karmadaclientset.Interface, memberClient kubeclient.Interface, result *PreflightResult) {
+	memberInfo, err := memberClient.Discovery().ServerVersion()
+	if err != nil {
+		result.add("KubernetesVersion", PreflightCheckWarning, "failed to get the member cluster version: %v", err)
+		return
+	}
+	memberGitVersion := memberInfo.GitVersion
+	memberVersion, err := version.ParseGeneric(memberGitVersion)
+	if err != nil {
+		result.add("KubernetesVersion", PreflightCheckWarning, "failed to parse member cluster version %q: %v", memberGitVersion, err)
+		return
+	}
+	if memberVersion.LessThan(version.MustParseGeneric(minSupportedKubernetesVersion)) {
+		result.add("KubernetesVersion", PreflightCheckFailed, "member cluster version %s is older than the minimum supported %s",
+			memberGitVersion, minSupportedKubernetesVersion)
+		return
+	}
+
+	controlPlaneInfo, err := karmadaClient.Discovery().ServerVersion()
+	if err != nil {
+		result.add("KubernetesVersion", PreflightCheckWarning, "member cluster version is %s, failed to get the karmada-apiserver version: %v", memberGitVersion, err)
+		return
+	}
+	controlPlaneVersion, err := version.ParseGeneric(controlPlaneInfo.GitVersion)
+	if err == nil && memberVersion.Major() == controlPlaneVersion.Major() &&
+		memberVersion.Minor() > controlPlaneVersion.Minor()+maxKubernetesMinorSkew {
+		result.add("KubernetesVersion", PreflightCheckWarning, "member cluster version %s is more than %d minor version ahead of karmada-apiserver %s",
+			memberGitVersion, maxKubernetesMinorSkew, controlPlaneInfo.GitVersion)
+		return
+	}
+	result.add("KubernetesVersion", PreflightCheckPassed, "member cluster version %s is supported", memberGitVersion)
+}
+
+func checkClusterName(opts *preflightOption, result *PreflightResult) {
+	_, exist, err := karmadautil.GetClusterWithKarmadaClient(opts.karmadaClient, opts.memberClusterName)
+	switch {
+	case err != nil:
+		result.add("ClusterName", PreflightCheckFailed, "failed to look up cluster %s: %v", opts.memberClusterName, err)
+	case exist:
+		result.add("ClusterName", PreflightCheckFailed, "a cluster named %s already exists", opts.memberClusterName)
+	default:
+		result.add("ClusterName", PreflightCheckPassed, "cluster name %s is available", opts.memberClusterName)
+	}
+}
+
+// checkClusterIdentity detects a member cluster that is already joined to this Karmada under another name.
+func checkClusterIdentity(ctx context.Context, karmadaClient karmadaclientset.Interface, memberClient kubeclient.Interface, result *PreflightResult) {
+	clusterID, err := karmadautil.ObtainClusterID(memberClient)
+	if err != nil {
+		result.add("ClusterIdentity", PreflightCheckWarning, "failed to obtain the member cluster ID: %v", err)
+		return
+	}
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, helpers.ListEverything)
+	if err != nil {
+		result.add("ClusterIdentity", PreflightCheckWarning, "failed to list clusters: %v", err)
+		return
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.ID == clusterID {
+			result.add("ClusterIdentity", PreflightCheckFailed, "the member cluster is already joined as %s", cluster.Name)
+			return
+		}
+	}
+	result.add("ClusterIdentity", PreflightCheckPassed, "the member cluster is not joined yet")
+}
+
+// checkExistingKarmada detects a member cluster that is already joined to another Karmada control plane.
+func checkExistingKarmada(ctx context.Context, memberClient kubeclient.Interface, opts *preflightOption, result *PreflightResult) {
+	var found []string
+	agents, err := memberClient.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=" + names.KarmadaAgentComponentName,
+	})
+	if err != nil {
+		result.add("ExistingKarmada", PreflightCheckWarning, "failed to look for karmada-agent: %v", err)
+		return
+	}
+	for _, agent := range agents.Items {
+		found = append(found, fmt.Sprintf("Deployment %s/%s", agent.Namespace, agent.Name))
+	}
+	if opts.syncMode == clusterv1alpha1.Pull && opts.memberClusterNamespace != "" {
+		_, err = memberClient.CoreV1().Secrets(opts.memberClusterNamespace).Get(ctx, KarmadaKubeconfigName, metav1.GetOptions{})
+		if err == nil {
+			found = append(found, fmt.Sprintf("Secret %s/%s", opts.memberClusterNamespace, KarmadaKubeconfigName))
+		}
+	}
+	namespaces, err := memberClient.CoreV1().Namespaces().List(ctx, helpers.ListEverything)
+	if err != nil {
+		result.add("ExistingKarmada", PreflightCheckWarning, "failed to list namespaces: %v", err)
+		return
+	}
+	for _, namespace := range namespaces.Items {
+		if strings.HasPrefix(namespace.Name, names.ExecutionSpacePrefix) {
+			found = append(found, fmt.Sprintf("Namespace %s", namespace.Name))
+		}
+	}
+	if len(found) > 0 {
+		result.add("ExistingKarmada", PreflightCheckFailed, "the member cluster looks managed by another Karmada: %s", strings.Join(found, ", "))
+		return
+	}
+	result.add("ExistingKarmada", PreflightCheckPassed, "no karmada-agent or execution namespace found")
+}
+
+// checkKarmadaEndpoint checks, for pull mode, that the karmada-apiserver endpoint handed to karmada-agent
+// is not only reachable from inside the control plane. Actual reachability from the member cluster
+// cannot be verified without deploying a probe there.
+func checkKarmadaEndpoint(opts *preflightOption, result *PreflightResult) {
+	if opts.syncMode != clusterv1alpha1.Pull {
+		return
+	}
+	restConfig, _, err := getKarmadaConfig()
+	if err != nil {
+		result.add("KarmadaEndpoint", PreflightCheckFailed, "failed to get the karmada-apiserver config: %v", err)
+		return
+	}
+	endpoint, err := url.Parse(restConfig.Host)
+	if err != nil {
+		result.add("KarmadaEndpoint", PreflightCheckFailed, "failed to parse karmada-apiserver endpoint %s: %v", restConfig.Host, err)
+		return
+	}
+	host := endpoint.Hostname()
+	if ip := net.ParseIP(host); (ip != nil && ip.IsLoopback()) || host == "localhost" ||
+		strings.HasSuffix(host, ".svc") || strings.Contains(host, ".svc.") {
+		result.add("KarmadaEndpoint", PreflightCheckFailed, "karmada-apiserver endpoint %s is only reachable from the host cluster", restConfig.Host)
+		return
+	}
+	conn, err := dialEndpoint("tcp", endpointAddress(endpoint), preflightTimeout)
+	if err != nil {
+		result.add("KarmadaEndpoint", PreflightCheckWarning, "karmada-apiserver endpoint %s is not reachable from the dashboard: %v", restConfig.Host, err)
+		return
+	}
+	_ = conn.Close()
+	result.add("KarmadaEndpoint", PreflightCheckPassed, "karmada-apiserver endpoint %s is reachable, make sure the member cluster can reach it too", restConfig.Host)
+}
+
+func endpointAddress(endpoint *url.URL) string {
+	if endpoint.Port() != "" {
+		return endpoint.Host
+	}
+	if endpoint.Scheme == "http" {
+		return net.JoinHostPort(endpoint.Hostname(), "80")
+	}
+	return net.JoinHostPort(endpoint.Hostname(), "443")
+}
@@ -0,0 +1,458 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const preflightMemberID = "member-cluster-id"
+
+// newPreflightMember returns a member cluster running gitVersion whose kube-system namespace has
+// the UID preflightMemberID, and whose access reviews answer allowed.
+func newPreflightMember(gitVersion string, allowed bool, objects ...runtime.Object) *fake.Clientset {
+	objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: metav1.NamespaceSystem, UID: types.UID(preflightMemberID),
+	}})
+	memberClient := fake.NewSimpleClientset(objects...)
+	memberClient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: gitVersion}
+	memberClient.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview).DeepCopy()
+		review.Status.Allowed = allowed
+		return true, review, nil
+	})
+	return memberClient
+}
+
+func newPreflightKarmada(gitVersion string, objects ...runtime.Object) *karmadafake.Clientset {
+	karmadaClient := karmadafake.NewSimpleClientset(objects...)
+	karmadaClient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: gitVersion}
+	return karmadaClient
+}
+
+func checkStatuses(result *PreflightResult) map[string]PreflightCheckStatus {
+	statuses := make(map[string]PreflightCheckStatus, len(result.Checks))
+	for _, check := range result.Checks {
+		statuses[check.Name] = check.Status
+	}
+	return statuses
+}
+
+func TestRunPreflight(t *testing.T) {
+	joinedCluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Spec:       clusterv1alpha1.ClusterSpec{ID: preflightMemberID},
+	}
+	allPassed := map[string]PreflightCheckStatus{
+		"Kubeconfig":        PreflightCheckPassed,
+		"Reachability":      PreflightCheckPassed,
+		"Credentials":       PreflightCheckPassed,
+		"KubernetesVersion": PreflightCheckPassed,
+		"ClusterName":       PreflightCheckPassed,
+		"ClusterIdentity":   PreflightCheckPassed,
+		"ExistingKarmada":   PreflightCheckPassed,
+	}
+	with := func(overrides map[string]PreflightCheckStatus) map[string]PreflightCheckStatus {
+		statuses := make(map[string]PreflightCheckStatus, len(allPassed))
+		for name, status := range allPassed {
+			statuses[name] = status
+		}
+		for name, status := range overrides {
+			statuses[name] = status
+		}
+		return statuses
+	}
+
+	tests := []struct {
+		name          string
+		kubeConfig    string
+		memberVersion string
+		notAdmin      bool
+		unreachable   bool
+		clusters      []runtime.Object
+		want          map[string]PreflightCheckStatus
+		wantPassed    bool
+	}{
+		{
+			name:          "joinable cluster passes every check",
+			kubeConfig:    insecureMemberKubeConfig,
+			memberVersion: "v1.30.2",
+			want:          allPassed,
+			wantPassed:    true,
+		},
+		{
+			name:          "member ahead of the control plane only warns",
+			kubeConfig:    insecureMemberKubeConfig,
+			memberVersion: "v1.32.0",
+			want:          with(map[string]PreflightCheckStatus{"KubernetesVersion": PreflightCheckWarning}),
+			wantPassed:    true,
+		},
+		{
+			name:          "member older than the compatibility matrix fails",
+			kubeConfig:    insecureMemberKubeConfig,
+			memberVersion: "v1.15.3",
+			want:          with(map[string]PreflightCheckStatus{"KubernetesVersion": PreflightCheckFailed}),
+		},
+		{
+			name:          "credentials without cluster-admin fail",
+			kubeConfig:    insecureMemberKubeConfig,
+			memberVersion: "v1.30.2",
+			notAdmin:      true,
+			want:          with(map[string]PreflightCheckStatus{"Credentials": PreflightCheckFailed}),
+		},
+		{
+			name:          "cluster already joined fails name and identity",
+			kubeConfig:    insecureMemberKubeConfig,
+			memberVersion: "v1.30.2",
+			clusters:      []runtime.Object{joinedCluster},
+			want: with(map[string]PreflightCheckStatus{
+				"ClusterName":     PreflightCheckFailed,
+				"ClusterIdentity": PreflightCheckFailed,
+			}),
+		},
+		{
+			name:        "unreachable member skips the member checks",
+			kubeConfig:  insecureMemberKubeConfig,
+			unreachable: true,
+			want: with(map[string]PreflightCheckStatus{
+				"Reachability":      PreflightCheckFailed,
+				"Credentials":       PreflightCheckSkipped,
+				"KubernetesVersion": PreflightCheckSkipped,
+				"ClusterIdentity":   PreflightCheckSkipped,
+				"ExistingKarmada":   PreflightCheckSkipped,
+			}),
+		},
+		{
+			name:       "invalid kubeconfig still checks the cluster name",
+			kubeConfig: "not a kubeconfig",
+			clusters:   []runtime.Object{joinedCluster},
+			want: with(map[string]PreflightCheckStatus{
+				"Kubeconfig":        PreflightCheckFailed,
+				"Reachability":      PreflightCheckSkipped,
+				"Credentials":       PreflightCheckSkipped,
+				"KubernetesVersion": PreflightCheckSkipped,
+				"ClusterName":       PreflightCheckFailed,
+				"ClusterIdentity":   PreflightCheckSkipped,
+				"ExistingKarmada":   PreflightCheckSkipped,
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memberClient := newPreflightMember(tt.memberVersion, !tt.notAdmin)
+			if tt.unreachable {
+				memberClient.PrependReactor("get", "version", func(clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("connection refused")
+				})
+			}
+			defer func(old func(*rest.Config) (kubeclient.Interface, error)) { newClientForConfig = old }(newClientForConfig)
+			newClientForConfig = func(*rest.Config) (kubeclient.Interface, error) { return memberClient, nil }
+
+			result := runPreflight(context.TODO(), &preflightOption{
+				karmadaClient:           newPreflightKarmada("v1.30.0", tt.clusters...),
+				memberClusterKubeConfig: tt.kubeConfig,
+				memberClusterName:       "member1",
+				syncMode:                clusterv1alpha1.Push,
+			})
+			if result.Passed != tt.wantPassed {
+				t.Errorf("Passed = %v, want %v, checks: %+v", result.Passed, tt.wantPassed, result.Checks)
+			}
+			if err := result.failedChecks(); (err == nil) != tt.wantPassed {
+				t.Errorf("failedChecks() = %v, want error %v", err, !tt.wantPassed)
+			}
+			got := checkStatuses(result)
+			if len(got) != len(tt.want) {
+				t.Errorf("checks = %v, want %v", got, tt.want)
+			}
+			for name, status := range tt.want {
+				if got[name] != status {
+					t.Errorf("check %s = %q, want %q", name, got[name], status)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckClusterIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		noKubeSystem bool
+		clusters     []runtime.Object
+		listErr      error
+		want         PreflightCheckStatus
+	}{
+		{
+			name: "member not joined under any name passes",
+			clusters: []runtime.Object{&clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member2"},
+				Spec:       clusterv1alpha1.ClusterSpec{ID: "another-cluster-id"},
+			}},
+			want: PreflightCheckPassed,
+		},
+		{
+			name: "member joined under another name fails",
+			clusters: []runtime.Object{&clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member2"},
+				Spec:       clusterv1alpha1.ClusterSpec{ID: preflightMemberID},
+			}},
+			want: PreflightCheckFailed,
+		},
+		{
+			name:         "member without cluster ID warns",
+			noKubeSystem: true,
+			want:         PreflightCheckWarning,
+		},
+		{
+			name:    "failing cluster list warns",
+			listErr: errors.New("etcd timeout"),
+			want:    PreflightCheckWarning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memberClient := newPreflightMember("v1.30.2", true)
+			if tt.noKubeSystem {
+				memberClient = fake.NewSimpleClientset()
+			}
+			karmadaClient := karmadafake.NewSimpleClientset(tt.clusters...)
+			if tt.listErr != nil {
+				karmadaClient.PrependReactor("list", "clusters", func(clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.listErr
+				})
+			}
+
+			result := &PreflightResult{Passed: true}
+			checkClusterIdentity(context.TODO(), karmadaClient, memberClient, result)
+			if got := checkStatuses(result)["ClusterIdentity"]; got != tt.want {
+				t.Errorf("ClusterIdentity = %q, want %q: %+v", got, tt.want, result.Checks)
+			}
+			if result.Passed != (tt.want != PreflightCheckFailed) {
+				t.Errorf("Passed = %v with status %q", result.Passed, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckExistingKarmada(t *testing.T) {
+	agent := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "karmada-system", Name: names.KarmadaAgentComponentName,
+		Labels: map[string]string{"app": names.KarmadaAgentComponentName},
+	}}
+	otherDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "default", Name: "nginx", Labels: map[string]string{"app": "nginx"},
+	}}
+	agentKubeconfig := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: KarmadaKubeconfigName}}
+	executionSpace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: names.ExecutionSpacePrefix + "member2"}}
+
+	tests := []struct {
+		name          string
+		syncMode      clusterv1alpha1.ClusterSyncMode
+		memberObjects []runtime.Object
+		listErr       error
+		want          PreflightCheckStatus
+	}{
+		{
+			name:          "clean member passes",
+			syncMode:      clusterv1alpha1.Pull,
+			memberObjects: []runtime.Object{otherDeployment},
+			want:          PreflightCheckPassed,
+		},
+		{
+			name:          "karmada-agent deployment fails",
+			syncMode:      clusterv1alpha1.Push,
+			memberObjects: []runtime.Object{agent},
+			want:          PreflightCheckFailed,
+		},
+		{
+			name:          "execution namespace fails",
+			syncMode:      clusterv1alpha1.Push,
+			memberObjects: []runtime.Object{executionSpace},
+			want:          PreflightCheckFailed,
+		},
+		{
+			name:          "agent kubeconfig secret fails in pull mode",
+			syncMode:      clusterv1alpha1.Pull,
+			memberObjects: []runtime.Object{agentKubeconfig},
+			want:          PreflightCheckFailed,
+		},
+		{
+			name:          "agent kubeconfig secret is ignored in push mode",
+			syncMode:      clusterv1alpha1.Push,
+			memberObjects: []runtime.Object{agentKubeconfig},
+			want:          PreflightCheckPassed,
+		},
+		{
+			name:     "failing deployment list warns",
+			syncMode: clusterv1alpha1.Push,
+			listErr:  errors.New("forbidden"),
+			want:     PreflightCheckWarning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memberClient := newPreflightMember("v1.30.2", true, tt.memberObjects...)
+			if tt.listErr != nil {
+				memberClient.PrependReactor("list", "deployments", func(clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.listErr
+				})
+			}
+
+			result := &PreflightResult{Passed: true}
+			checkExistingKarmada(context.TODO(), memberClient, &preflightOption{
+				memberClusterNamespace: "karmada-system",
+				syncMode:               tt.syncMode,
+			}, result)
+			if got := checkStatuses(result)["ExistingKarmada"]; got != tt.want {
+				t.Errorf("ExistingKarmada = %q, want %q: %+v", got, tt.want, result.Checks)
+			}
+		})
+	}
+}
+
+func TestCheckKarmadaEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		syncMode  clusterv1alpha1.ClusterSyncMode
+		host      string
+		configErr error
+		dialErr   error
+		// want is empty when no check is expected
+		want     PreflightCheckStatus
+		wantDial string
+	}{
+		{
+			name:     "push mode is not checked",
+			syncMode: clusterv1alpha1.Push,
+			host:     "https://127.0.0.1:5443",
+		},
+		{
+			name:      "missing karmada config fails",
+			syncMode:  clusterv1alpha1.Pull,
+			configErr: errors.New("client package not initialized"),
+			want:      PreflightCheckFailed,
+		},
+		{
+			name:     "loopback endpoint fails",
+			syncMode: clusterv1alpha1.Pull,
+			host:     "https://127.0.0.1:5443",
+			want:     PreflightCheckFailed,
+		},
+		{
+			name:     "localhost endpoint fails",
+			syncMode: clusterv1alpha1.Pull,
+			host:     "https://localhost:5443",
+			want:     PreflightCheckFailed,
+		},
+		{
+			name:     "in-cluster service endpoint fails",
+			syncMode: clusterv1alpha1.Pull,
+			host:     "https://karmada-apiserver.karmada-system.svc.cluster.local:5443",
+			want:     PreflightCheckFailed,
+		},
+		{
+			name:     "unreachable endpoint warns",
+			syncMode: clusterv1alpha1.Pull,
+			host:     "https://karmada.example.com",
+			dialErr:  errors.New("i/o timeout"),
+			want:     PreflightCheckWarning,
+			wantDial: "karmada.example.com:443",
+		},
+		{
+			name:     "reachable endpoint passes",
+			syncMode: clusterv1alpha1.Pull,
+			host:     "https://karmada.example.com:5443",
+			want:     PreflightCheckPassed,
+			wantDial: "karmada.example.com:5443",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(old func() (*rest.Config, *clientcmdapi.Config, error)) { getKarmadaConfig = old }(getKarmadaConfig)
+			getKarmadaConfig = func() (*rest.Config, *clientcmdapi.Config, error) {
+				if tt.configErr != nil {
+					return nil, nil, tt.configErr
+				}
+				return &rest.Config{Host: tt.host}, nil, nil
+			}
+			var dialed string
+			defer func(old func(string, string, time.Duration) (net.Conn, error)) { dialEndpoint = old }(dialEndpoint)
+			dialEndpoint = func(_, address string, _ time.Duration) (net.Conn, error) {
+				dialed = address
+				if tt.dialErr != nil {
+					return nil, tt.dialErr
+				}
+				conn, peer := net.Pipe()
+				_ = peer.Close()
+				return conn, nil
+			}
+
+			result := &PreflightResult{Passed: true}
+			checkKarmadaEndpoint(&preflightOption{syncMode: tt.syncMode}, result)
+			if got := checkStatuses(result)["KarmadaEndpoint"]; got != tt.want {
+				t.Errorf("KarmadaEndpoint = %q, want %q: %+v", got, tt.want, result.Checks)
+			}
+			if dialed != tt.wantDial {
+				t.Errorf("dialed %q, want %q", dialed, tt.wantDial)
+			}
+		})
+	}
+}
+
+func TestEndpointAddress(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{endpoint: "https://karmada.example.com:5443", want: "karmada.example.com:5443"},
+		{endpoint: "https://karmada.example.com", want: "karmada.example.com:443"},
+		{endpoint: "http://karmada.example.com", want: "karmada.example.com:80"},
+		{endpoint: "https://[fd00::1]", want: "[fd00::1]:443"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			endpoint, err := url.Parse(tt.endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := endpointAddress(endpoint); got != tt.want {
+				t.Errorf("endpointAddress(%s) = %s, want %s", tt.endpoint, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	cmdutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+const (
+	unjoinStepGetCluster    = "Get cluster"
+	unjoinStepDrain         = "Drain workloads"
+	unjoinStepDeleteCluster = "Delete cluster object"
+	unjoinStepCleanupMember = "Remove karmada artifacts from member cluster"
+)
+
+type unjoinOption struct {
+	karmadaClient      karmadaclientset.Interface
+	controlPlaneClient kubeclient.Interface
+	// memberClusterClient is nil when the member cluster kubeconfig is not provided, the artifacts
+	// in the member cluster are left untouched then.
+	memberClusterClient kubeclient.Interface
+	clusterName         string
+	// agentNamespace is the namespace of the karmada-agent of a pull mode cluster.
+	agentNamespace string
+	drain          bool
+	// force removes the finalizers blocking the deletion once the timeout expires, and ignores
+	// errors when cleaning up the member cluster, for clusters that are unreachable.
+	force   bool
+	timeout time.Duration
+}
+
+// unjoinSteps lists the steps unjoinCluster runs for the given options.
+func unjoinSteps(opts *unjoinOption) []string {
+	steps := []string{unjoinStepGetCluster}
+	if opts.drain {
+		steps = append(steps, unjoinStepDrain)
+	}
+	steps = append(steps, unjoinStepDeleteCluster)
+	if opts.memberClusterClient != nil {
+		steps = append(steps, unjoinStepCleanupMember)
+	}
+	return steps
+}
+
+// unjoinCluster removes a cluster from karmada like `karmadactl unjoin` and `karmadactl unregister`.
+func unjoinCluster(ctx context.Context, tracker *operation.Tracker, opts *unjoinOption) error {
+	var cluster *clusterv1alpha1.Cluster
+	err := tracker.Step(unjoinStepGetCluster, func() error {
+		var err error
+		cluster, err = opts.karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, opts.clusterName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) && opts.force {
+			cluster = nil
+			tracker.Note(unjoinStepGetCluster, fmt.Sprintf("no cluster object %s found, only the member cluster is cleaned up", opts.clusterName))
+			return nil
+		}
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("no cluster object %s found in karmada control Plane", opts.clusterName)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.drain {
+		err = tracker.Step(unjoinStepDrain, func() error {
+			if cluster == nil {
+				return nil
+			}
+			remaining, err := drainCluster(ctx, opts.karmadaClient, opts.clusterName, opts.timeout)
+			if err != nil && opts.force {
+				tracker.Note(unjoinStepDrain, fmt.Sprintf("drain timed out, workloads still on the cluster: %s", strings.Join(workloadNames(remaining), ", ")))
+				return nil
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tracker.Step(unjoinStepDeleteCluster, func() error {
+		if cluster == nil {
+			return nil
+		}
+		return deleteClusterObject(ctx, tracker, opts, cluster.Spec.SyncMode)
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.memberClusterClient == nil {
+		return nil
+	}
+	return tracker.Step(unjoinStepCleanupMember, func() error {
+		var objects []memberArtifact
+		if cluster == nil || cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+			objects = append(objects, pullModeArtifacts(opts.agentNamespace)...)
+		}
+		if cluster == nil || cluster.Spec.SyncMode == clusterv1alpha1.Push {
+			objects = append(objects, pushModeArtifacts(opts.clusterName)...)
+		}
+		var failed []string
+		for _, object := range objects {
+			if err := object.delete(ctx, opts.memberClusterClient); err != nil && !apierrors.IsNotFound(err) {
+				if !opts.force {
+					return fmt.Errorf("failed to delete %s: %w", object.description, err)
+				}
+				klog.ErrorS(err, "Force deletion. Could not delete member cluster object", "cluster", opts.clusterName, "object", object.description)
+				failed = append(failed, object.description)
+			}
+		}
+		if len(failed) > 0 {
+			tracker.Note(unjoinStepCleanupMember, fmt.Sprintf("left in the member cluster: %s", strings.Join(failed, ", ")))
+		}
+		return nil
+	})
+}
+
+// deleteClusterObject deletes the Cluster object and waits until it is gone. When the deletion does
+// not finish in time, the finalizers blocking it are reported, or removed in force mode.
+func deleteClusterObject(ctx context.Context, tracker *operation.Tracker, opts *unjoinOption, syncMode clusterv1alpha1.ClusterSyncMode) error {
+	// Like `karmadactl unregister`, the Works of a pull mode cluster go first, as deleting the
+	// execution namespace also removes the RBAC the karmada-agent needs to clean them up.
+	if syncMode == clusterv1alpha1.Pull {
+		executionSpace := names.GenerateExecutionSpaceName(opts.clusterName)
+		if err := cmdutil.EnsureWorksDeleted(opts.karmadaClient, executionSpace, opts.timeout); err != nil {
+			if !opts.force {
+				blockers, blockersErr := clusterDeletionBlockers(ctx, opts.karmadaClient, opts.controlPlaneClient, opts.clusterName)
+				if blockersErr != nil {
+					return blockersErr
+				}
+				return fmt.Errorf("works of cluster %s are not deleted after %s, blocked by: %s", opts.clusterName, opts.timeout, strings.Join(blockers, "; "))
+			}
+			klog.ErrorS(err, "Force deletion. Works are not deleted in time", "cluster", opts.clusterName)
+		}
+	}
+
+	err := opts.karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, opts.clusterName, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = waitForClusterDeletion(ctx, opts.karmadaClient, opts.clusterName, opts.timeout); err == nil {
+		return nil
+	}
+
+	blockers, err := clusterDeletionBlockers(ctx, opts.karmadaClient, opts.controlPlaneClient, opts.clusterName)
+	if err != nil {
+		return err
+	}
+	if !opts.force {
+		return fmt.Errorf("cluster %s is not deleted after %s, blocked by: %s", opts.clusterName, opts.timeout, strings.Join(blockers, "; "))
+	}
+
+	klog.InfoS("Start forced deletion", "cluster", opts.clusterName)
+	if err = removeClusterDeletionFinalizers(ctx, opts.karmadaClient, opts.controlPlaneClient, opts.clusterName); err != nil {
+		return err
+	}
+	tracker.Note(unjoinStepDeleteCluster, fmt.Sprintf("removed finalizers from: %s", strings.Join(blockers, "; ")))
+	return waitForClusterDeletion(ctx, opts.karmadaClient, opts.clusterName, opts.timeout)
+}
+
+func waitForClusterDeletion(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string, timeout time.Duration) error {
+	return wait.PollUntilContextTimeout(ctx, 1*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		_, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		klog.V(4).InfoS("Waiting for the cluster object to be deleted", "cluster", clusterName)
+		return false, nil
+	})
+}
+
+// clusterDeletionBlockers describes the objects whose finalizers keep the cluster from being
+// deleted: the Cluster itself, its execution namespace and the Works inside it.
+func clusterDeletionBlockers(ctx context.Context, karmadaClient karmadaclientset.Interface, controlPlaneClient kubeclient.Interface, clusterName string) ([]string, error) {
+	var blockers []string
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && len(cluster.Finalizers) > 0 {
+		blockers = append(blockers, fmt.Sprintf("cluster %s finalizers %v", clusterName, cluster.Finalizers))
+	}
+
+	executionSpace := names.GenerateExecutionSpaceName(clusterName)
+	ns, err := controlPlaneClient.CoreV1().Namespaces().Get(ctx, executionSpace, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return blockers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(ns.Finalizers) > 0 || len(ns.Spec.Finalizers) > 0 {
+		blockers = append(blockers, fmt.Sprintf("namespace %s finalizers %v %v", executionSpace, ns.Finalizers, ns.Spec.Finalizers))
+	}
+	works, err := karmadaClient.WorkV1alpha1().Works(executionSpace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, work := range works.Items {
+		if len(work.Finalizers) > 0 {
+			blockers = append(blockers, fmt.Sprintf("work %s/%s finalizers %v", work.Namespace, work.Name, work.Finalizers))
+		}
+	}
+	return blockers, nil
+}
+
+// removeClusterDeletionFinalizers removes the finalizers karmada puts on the Works, the execution
+// namespace and the Cluster, in that order, like `karmadactl unjoin --force`. The resources in the
+// member cluster may remain.
+func removeClusterDeletionFinalizers(ctx context.Context, karmadaClient karmadaclientset.Interface, controlPlaneClient kubeclient.Interface, clusterName string) error {
+	executionSpace := names.GenerateExecutionSpaceName(clusterName)
+	works, err := karmadaClient.WorkV1alpha1().Works(executionSpace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list works in execution namespace %s: %w", executionSpace, err)
+	}
+	for i := range works.Items {
+		work := &works.Items[i]
+		finalizers, removed := removeFinalizer(work.Finalizers, karmadautil.ExecutionControllerFinalizer)
+		if !removed {
+			continue
+		}
+		work.Finalizers = finalizers
+		if _, err = karmadaClient.WorkV1alpha1().Works(executionSpace).Update(ctx, work, metav1.UpdateOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to remove the finalizer of work %s/%s: %w", executionSpace, work.Name, err)
+		}
+	}
+
+	ns, err := controlPlaneClient.CoreV1().Namespaces().Get(ctx, executionSpace, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if finalizers, removed := removeFinalizer(ns.Finalizers, string(corev1.FinalizerKubernetes)); removed {
+			ns.Finalizers = finalizers
+			if _, err = controlPlaneClient.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to remove the finalizer of namespace %s: %w", executionSpace, err)
+			}
+		}
+	}
+
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	finalizers, removed := removeFinalizer(cluster.Finalizers, karmadautil.ClusterControllerFinalizer)
+	if !removed {
+		return nil
+	}
+	cluster.Finalizers = finalizers
+	if _, err = karmadaClient.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove the finalizer of cluster %s: %w", clusterName, err)
+	}
+	return nil
+}
+
+func removeFinalizer(finalizers []string, finalizer string) ([]string, bool) {
+	result := make([]string, 0, len(finalizers))
+	for _, f := range finalizers {
+		if f != finalizer {
+			result = append(result, f)
+		}
+	}
+	return result, len(result) != len(finalizers)
+}
+
+// memberArtifact is an object the join created in the member cluster.
+type memberArtifact struct {
+	description string
+	delete      func(ctx context.Context, client kubeclient.Interface) error
+}
+
+// pullModeArtifacts lists what accessClusterInPullMode creates in the member cluster, the agent
+// deployment first so that it stops before its credentials disappear.
+func pullModeArtifacts(namespace string) []memberArtifact {
+	return []memberArtifact{
+		{
+			description: fmt.Sprintf("deployment %s/%s", namespace, KarmadaAgentName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.AppsV1().Deployments(namespace).Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("secret %s/%s", namespace, KarmadaKubeconfigName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.CoreV1().Secrets(namespace).Delete(ctx, KarmadaKubeconfigName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("clusterrolebinding %s", KarmadaAgentName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.RbacV1().ClusterRoleBindings().Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("clusterrole %s", KarmadaAgentName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.RbacV1().ClusterRoles().Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("serviceaccount %s/%s", namespace, KarmadaAgentServiceAccountName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.CoreV1().ServiceAccounts(namespace).Delete(ctx, KarmadaAgentServiceAccountName, metav1.DeleteOptions{})
+			},
+		},
+	}
+}
+
+// pushModeArtifacts lists what accessClusterInPushMode creates in the member cluster, the same
+// objects `karmadactl unjoin` removes.
+func pushModeArtifacts(clusterName string) []memberArtifact {
+	serviceAccountName := names.GenerateServiceAccountName(clusterName)
+	roleName := names.GenerateRoleName(serviceAccountName)
+	return []memberArtifact{
+		{
+			description: fmt.Sprintf("clusterrolebinding %s", roleName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.RbacV1().ClusterRoleBindings().Delete(ctx, roleName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("clusterrole %s", roleName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.RbacV1().ClusterRoles().Delete(ctx, roleName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("serviceaccount %s/%s", ClusterNamespace, serviceAccountName),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.CoreV1().ServiceAccounts(ClusterNamespace).Delete(ctx, serviceAccountName, metav1.DeleteOptions{})
+			},
+		},
+		{
+			description: fmt.Sprintf("namespace %s", ClusterNamespace),
+			delete: func(ctx context.Context, client kubeclient.Interface) error {
+				return client.CoreV1().Namespaces().Delete(ctx, ClusterNamespace, metav1.DeleteOptions{})
+			},
+		},
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+// foreignFinalizer is a finalizer owned by someone else than karmada, it must never be removed.
+const foreignFinalizer = "example.com/protect"
+
+func newUnjoinCluster(syncMode clusterv1alpha1.ClusterSyncMode, finalizers ...string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1", Finalizers: finalizers},
+		Spec:       clusterv1alpha1.ClusterSpec{SyncMode: syncMode},
+	}
+}
+
+func newExecutionSpaceWork(name string, finalizers ...string) *workv1alpha1.Work {
+	return &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{
+		Namespace:  names.GenerateExecutionSpaceName("member1"),
+		Name:       name,
+		Finalizers: finalizers,
+	}}
+}
+
+// honorClusterFinalizers makes the fake client keep a deleted cluster until its finalizers are
+// removed, the way the apiserver does.
+func honorClusterFinalizers(karmadaClient *karmadafake.Clientset) {
+	clusters := clusterv1alpha1.SchemeGroupVersion.WithResource("clusters")
+	karmadaClient.PrependReactor("delete", "clusters", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		obj, err := karmadaClient.Tracker().Get(clusters, "", action.(clienttesting.DeleteAction).GetName())
+		if err != nil {
+			return false, nil, nil
+		}
+		cluster := obj.(*clusterv1alpha1.Cluster)
+		if len(cluster.Finalizers) == 0 {
+			return false, nil, nil
+		}
+		now := metav1.Now()
+		cluster.DeletionTimestamp = &now
+		return true, nil, karmadaClient.Tracker().Update(clusters, cluster, "")
+	})
+	karmadaClient.PrependReactor("update", "clusters", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		cluster := action.(clienttesting.UpdateAction).GetObject().(*clusterv1alpha1.Cluster)
+		if cluster.DeletionTimestamp == nil || len(cluster.Finalizers) > 0 {
+			return false, nil, nil
+		}
+		return true, cluster, karmadaClient.Tracker().Delete(clusters, "", cluster.Name)
+	})
+}
+
+func newPushModeMember() *fake.Clientset {
+	serviceAccountName := names.GenerateServiceAccountName("member1")
+	roleName := names.GenerateRoleName(serviceAccountName)
+	return fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ClusterNamespace}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: ClusterNamespace, Name: serviceAccountName}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: roleName}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: roleName}},
+	)
+}
+
+func TestUnjoinCluster(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *clusterv1alpha1.Cluster
+		drain   bool
+		force   bool
+		wantErr string
+		// wantClusterGone tells whether the Cluster object is absent at the end
+		wantClusterGone bool
+		// wantMemberCleaned tells whether the push mode artifacts are removed from the member
+		wantMemberCleaned bool
+	}{
+		{
+			name:              "push mode cluster is drained, deleted and cleaned up",
+			cluster:           newUnjoinCluster(clusterv1alpha1.Push),
+			drain:             true,
+			wantClusterGone:   true,
+			wantMemberCleaned: true,
+		},
+		{
+			name:            "missing cluster fails without force",
+			wantErr:         "no cluster object member1 found",
+			wantClusterGone: true,
+		},
+		{
+			name:              "missing cluster only cleans up the member with force",
+			force:             true,
+			wantClusterGone:   true,
+			wantMemberCleaned: true,
+		},
+		{
+			name:    "blocked deletion reports the finalizers",
+			cluster: newUnjoinCluster(clusterv1alpha1.Push, karmadautil.ClusterControllerFinalizer),
+			wantErr: "blocked by: cluster member1 finalizers [" + karmadautil.ClusterControllerFinalizer + "]",
+		},
+		{
+			name:              "blocked deletion removes the karmada finalizers with force",
+			cluster:           newUnjoinCluster(clusterv1alpha1.Push, karmadautil.ClusterControllerFinalizer),
+			force:             true,
+			wantClusterGone:   true,
+			wantMemberCleaned: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset()
+			if tt.cluster != nil {
+				if err := karmadaClient.Tracker().Add(tt.cluster); err != nil {
+					t.Fatal(err)
+				}
+			}
+			honorClusterFinalizers(karmadaClient)
+			memberClient := newPushModeMember()
+			opts := &unjoinOption{
+				karmadaClient:       karmadaClient,
+				controlPlaneClient:  fake.NewSimpleClientset(),
+				memberClusterClient: memberClient,
+				clusterName:         "member1",
+				drain:               tt.drain,
+				force:               tt.force,
+				timeout:             50 * time.Millisecond,
+			}
+			tracker := operation.New(unjoinClusterOperation, "member1", unjoinSteps(opts)...)
+			err := unjoinCluster(context.TODO(), tracker, opts)
+			tracker.Finish(err)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unjoinCluster() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("unjoinCluster() error = %v, want %q", err, tt.wantErr)
+			}
+
+			_, err = karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+			if gone := apierrors.IsNotFound(err); gone != tt.wantClusterGone {
+				t.Errorf("cluster absent = %v, want %v", gone, tt.wantClusterGone)
+			}
+			_, err = memberClient.CoreV1().Namespaces().Get(context.TODO(), ClusterNamespace, metav1.GetOptions{})
+			if cleaned := apierrors.IsNotFound(err); cleaned != tt.wantMemberCleaned {
+				t.Errorf("member cleaned up = %v, want %v", cleaned, tt.wantMemberCleaned)
+			}
+		})
+	}
+}
+
+func TestClusterDeletionBlockers(t *testing.T) {
+	executionSpace := names.GenerateExecutionSpaceName("member1")
+	tests := []struct {
+		name      string
+		objects   []runtime.Object
+		namespace *corev1.Namespace
+		want      []string
+	}{
+		{
+			name:    "nothing blocks a cluster without finalizers",
+			objects: []runtime.Object{newUnjoinCluster(clusterv1alpha1.Push)},
+		},
+		{
+			name: "cluster, namespace and works with finalizers",
+			objects: []runtime.Object{
+				newUnjoinCluster(clusterv1alpha1.Push, karmadautil.ClusterControllerFinalizer),
+				newExecutionSpaceWork("blocked", karmadautil.ExecutionControllerFinalizer),
+				newExecutionSpaceWork("free"),
+			},
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: executionSpace},
+				Spec:       corev1.NamespaceSpec{Finalizers: []corev1.FinalizerName{corev1.FinalizerKubernetes}},
+			},
+			want: []string{
+				"cluster member1 finalizers [" + karmadautil.ClusterControllerFinalizer + "]",
+				"namespace " + executionSpace + " finalizers [] [kubernetes]",
+				"work " + executionSpace + "/blocked finalizers [" + karmadautil.ExecutionControllerFinalizer + "]",
+			},
+		},
+		{
+			name: "works are not listed without the execution namespace",
+			objects: []runtime.Object{
+				newExecutionSpaceWork("blocked", karmadautil.ExecutionControllerFinalizer),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controlPlaneClient := fake.NewSimpleClientset()
+			if tt.namespace != nil {
+				controlPlaneClient = fake.NewSimpleClientset(tt.namespace)
+			}
+			got, err := clusterDeletionBlockers(context.TODO(), karmadafake.NewSimpleClientset(tt.objects...), controlPlaneClient, "member1")
+			if err != nil {
+				t.Fatalf("clusterDeletionBlockers() error = %v", err)
+			}
+			if !equalKeys(got, tt.want) {
+				t.Errorf("clusterDeletionBlockers() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveClusterDeletionFinalizersKeepsForeignFinalizers(t *testing.T) {
+	ctx := context.TODO()
+	executionSpace := names.GenerateExecutionSpaceName("member1")
+	karmadaClient := karmadafake.NewSimpleClientset(
+		newUnjoinCluster(clusterv1alpha1.Push, karmadautil.ClusterControllerFinalizer, foreignFinalizer),
+		newExecutionSpaceWork("nginx", foreignFinalizer, karmadautil.ExecutionControllerFinalizer),
+		newExecutionSpaceWork("foreign", foreignFinalizer),
+	)
+	controlPlaneClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:       executionSpace,
+		Finalizers: []string{string(corev1.FinalizerKubernetes), foreignFinalizer},
+	}})
+
+	if err := removeClusterDeletionFinalizers(ctx, karmadaClient, controlPlaneClient, "member1"); err != nil {
+		t.Fatalf("removeClusterDeletionFinalizers() error = %v", err)
+	}
+
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, "member1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalKeys(cluster.Finalizers, []string{foreignFinalizer}) {
+		t.Errorf("cluster finalizers = %v, want only %s", cluster.Finalizers, foreignFinalizer)
+	}
+	for _, name := range []string{"nginx", "foreign"} {
+		work, err := karmadaClient.WorkV1alpha1().Works(executionSpace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalKeys(work.Finalizers, []string{foreignFinalizer}) {
+			t.Errorf("work %s finalizers = %v, want only %s", name, work.Finalizers, foreignFinalizer)
+		}
+	}
+	ns, err := controlPlaneClient.CoreV1().Namespaces().Get(ctx, executionSpace, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalKeys(ns.Finalizers, []string{foreignFinalizer}) {
+		t.Errorf("namespace finalizers = %v, want only %s", ns.Finalizers, foreignFinalizer)
+	}
+}
+
+func TestRemoveFinalizer(t *testing.T) {
+	tests := []struct {
+		name        string
+		finalizers  []string
+		want        []string
+		wantRemoved bool
+	}{
+		{name: "no finalizers"},
+		{name: "other finalizers only", finalizers: []string{foreignFinalizer}, want: []string{foreignFinalizer}},
+		{
+			name:        "finalizer removed, others kept in order",
+			finalizers:  []string{"a", karmadautil.ClusterControllerFinalizer, "b"},
+			want:        []string{"a", "b"},
+			wantRemoved: true,
+		},
+		{
+			name:        "duplicates removed",
+			finalizers:  []string{karmadautil.ClusterControllerFinalizer, karmadautil.ClusterControllerFinalizer},
+			wantRemoved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed := removeFinalizer(tt.finalizers, karmadautil.ClusterControllerFinalizer)
+			if !equalKeys(got, tt.want) || removed != tt.wantRemoved {
+				t.Errorf("removeFinalizer() = %v, %v, want %v, %v", got, removed, tt.want, tt.wantRemoved)
+			}
+		})
+	}
+}
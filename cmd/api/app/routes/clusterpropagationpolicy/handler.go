@@ -26,8 +26,11 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+	"github.com/karmada-io/dashboard/pkg/policyrevision"
 	"github.com/karmada-io/dashboard/pkg/resource/clusterpropagationpolicy"
 )
 
@@ -77,6 +80,8 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	var revisionRef policyrevision.PolicyRef
+	var revisionSpec v1alpha1.PropagationSpec
 	if propagationpolicyRequest.IsClusterScope {
 		clusterPropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterPropagationPolicy); err != nil {
@@ -84,7 +89,12 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterPropagationPolicy, metav1.CreateOptions{})
+		var created *v1alpha1.ClusterPropagationPolicy
+		created, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterPropagationPolicy, metav1.CreateOptions{})
+		if err == nil {
+			revisionRef = policyrevision.PolicyRef{Kind: policyrevision.ClusterPropagationPolicyKind, Name: created.Name}
+			revisionSpec = created.Spec
+		}
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
@@ -92,13 +102,20 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
+		var created *v1alpha1.PropagationPolicy
+		created, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
+		if err == nil {
+			revisionRef = policyrevision.PolicyRef{Kind: policyrevision.PropagationPolicyKind, Namespace: created.Namespace, Name: created.Name}
+			revisionSpec = created.Spec
+		}
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to create PropagationPolicy")
 		common.Fail(c, err)
 		return
 	}
+	_, err = policyrevision.RecordRevision(c, revisionRef, auth.GetCurrentUserName(c), revisionSpec)
+	configmapstore.LogFailure(err, "Failed to record revision", "kind", revisionRef.Kind, "namespace", revisionRef.Namespace, "name", revisionRef.Name)
 	common.Success(c, "ok")
 }
 
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterregistration
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/bootstraptoken"
+	"github.com/karmada-io/dashboard/pkg/resource/certificatesigningrequest"
+)
+
+func handleGetBootstrapTokenList(c *gin.Context) {
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := bootstraptoken.GetBootstrapTokenList(kubeClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetBootstrapTokenList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostBootstrapToken(c *gin.Context) {
+	tokenRequest := new(v1.CreateBootstrapTokenRequest)
+	if err := c.ShouldBind(tokenRequest); err != nil {
+		klog.ErrorS(err, "Could not read bootstrap token request")
+		common.Fail(c, err)
+		return
+	}
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	endpoint, caData, err := karmadaAPIServerInfo()
+	if err != nil {
+		klog.ErrorS(err, "Get karmada-apiserver endpoint and CA failed")
+		common.Fail(c, err)
+		return
+	}
+	if tokenRequest.APIServerEndpoint != "" {
+		endpoint = tokenRequest.APIServerEndpoint
+	}
+	result, err := bootstraptoken.CreateBootstrapToken(kubeClient, &bootstraptoken.BootstrapTokenSpec{
+		TTL:               time.Duration(tokenRequest.TTLSeconds) * time.Second,
+		Description:       tokenRequest.Description,
+		APIServerEndpoint: endpoint,
+		CACertificates:    caData,
+	})
+	if err != nil {
+		klog.ErrorS(err, "CreateBootstrapToken failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleExpireBootstrapToken(c *gin.Context) {
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := bootstraptoken.ExpireBootstrapToken(kubeClient, c.Param("id"))
+	if err != nil {
+		klog.ErrorS(err, "ExpireBootstrapToken failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleDeleteBootstrapToken(c *gin.Context) {
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = bootstraptoken.DeleteBootstrapToken(kubeClient, c.Param("id")); err != nil {
+		klog.ErrorS(err, "DeleteBootstrapToken failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleGetCertificateSigningRequestList(c *gin.Context) {
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := certificatesigningrequest.GetCertificateSigningRequestList(kubeClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetCertificateSigningRequestList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleApproveCertificateSigningRequest(approve bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		approvalRequest := new(v1.CertificateSigningRequestApprovalRequest)
+		// the body is optional
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBind(approvalRequest); err != nil {
+				klog.ErrorS(err, "Could not read certificate signing request approval")
+				common.Fail(c, err)
+				return
+			}
+		}
+		kubeClient, err := router.GetKubeClientFromContext(c)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		result, err := certificatesigningrequest.ApproveCertificateSigningRequest(kubeClient, c.Param("name"), approve, approvalRequest.Message)
+		if err != nil {
+			klog.ErrorS(err, "ApproveCertificateSigningRequest failed", "approve", approve)
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
+	}
+}
+
+// karmadaAPIServerInfo returns the server and CA bundle of the karmada kubeconfig the dashboard uses.
+func karmadaAPIServerInfo() (string, []byte, error) {
+	_, apiConfig, err := client.GetKarmadaConfig()
+	if err != nil {
+		return "", nil, err
+	}
+	kubeContext, ok := apiConfig.Contexts[apiConfig.CurrentContext]
+	if !ok {
+		return "", nil, fmt.Errorf("context %q not found in karmada kubeconfig", apiConfig.CurrentContext)
+	}
+	cluster, ok := apiConfig.Clusters[kubeContext.Cluster]
+	if !ok {
+		return "", nil, fmt.Errorf("cluster %q not found in karmada kubeconfig", kubeContext.Cluster)
+	}
+	caData := cluster.CertificateAuthorityData
+	if len(caData) == 0 && cluster.CertificateAuthority != "" {
+		if caData, err = os.ReadFile(cluster.CertificateAuthority); err != nil {
+			return "", nil, err
+		}
+	}
+	if len(caData) == 0 {
+		return "", nil, fmt.Errorf("no CA certificates found in karmada kubeconfig")
+	}
+	return cluster.Server, caData, nil
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/clusterregistration/token", handleGetBootstrapTokenList)
+	r.POST("/clusterregistration/token", handlePostBootstrapToken)
+	r.PUT("/clusterregistration/token/:id/expire", handleExpireBootstrapToken)
+	r.DELETE("/clusterregistration/token/:id", handleDeleteBootstrapToken)
+	r.GET("/clusterregistration/csr", handleGetCertificateSigningRequestList)
+	r.PUT("/clusterregistration/csr/:name/approve", handleApproveCertificateSigningRequest(true))
+	r.PUT("/clusterregistration/csr/:name/deny", handleApproveCertificateSigningRequest(false))
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourcebinding
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/clusterresourcebinding"
+)
+
+func handleGetClusterResourceBindingList(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := clusterresourcebinding.GetClusterResourceBindingList(karmadaClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetClusterResourceBindingList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetClusterResourceBindingDetail(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	name := c.Param("clusterResourceBindingName")
+	result, err := clusterresourcebinding.GetClusterResourceBindingDetail(karmadaClient, name)
+	if err != nil {
+		klog.ErrorS(err, "GetClusterResourceBindingDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/clusterresourcebinding", handleGetClusterResourceBindingList)
+	r.GET("/clusterresourcebinding/:clusterResourceBindingName", handleGetClusterResourceBindingDetail)
+}
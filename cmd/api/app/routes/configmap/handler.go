@@ -20,8 +20,10 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/configmap"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetConfigMap(c *gin.Context) {
@@ -61,4 +63,7 @@ func init() {
 	r.GET("/configmap", handleGetConfigMap)
 	r.GET("/configmap/:namespace", handleGetConfigMap)
 	r.GET("/configmap/:namespace/:name", handleGetConfigMapDetail)
+	r.POST("/configmap", resourcetemplate.HandleCreate(template.ConfigMapKind))
+	r.PUT("/configmap/:namespace/:name", resourcetemplate.HandleUpdate(template.ConfigMapKind, "name"))
+	r.DELETE("/configmap/:namespace/:name", resourcetemplate.HandleDelete(template.ConfigMapKind, "name"))
 }
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/cronfederatedhpa"
+)
+
+func handleGetCronFederatedHPAList(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := cronfederatedhpa.GetCronFederatedHPAList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetCronFederatedHPAList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetCronFederatedHPADetail(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := cronfederatedhpa.GetCronFederatedHPADetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetCronFederatedHPADetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostCronFederatedHPA(c *gin.Context) {
+	req := new(v1.PostFederatedHPARequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := cronfederatedhpa.CreateCronFederatedHPA(c, karmadaClient, req.Namespace, req.Content)
+	if err != nil {
+		klog.ErrorS(err, "CreateCronFederatedHPA failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePutCronFederatedHPA(c *gin.Context) {
+	req := new(v1.PutFederatedHPARequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := cronfederatedhpa.UpdateCronFederatedHPA(c, karmadaClient, c.Param("namespace"), c.Param("name"), req.Content)
+	if err != nil {
+		klog.ErrorS(err, "UpdateCronFederatedHPA failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/cronfederatedhpa", handleGetCronFederatedHPAList)
+	r.GET("/cronfederatedhpa/:namespace", handleGetCronFederatedHPAList)
+	r.GET("/cronfederatedhpa/:namespace/:name", handleGetCronFederatedHPADetail)
+	r.POST("/cronfederatedhpa", handlePostCronFederatedHPA)
+	r.PUT("/cronfederatedhpa/:namespace/:name", handlePutCronFederatedHPA)
+}
@@ -20,9 +20,11 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/cronjob"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetCronJob(c *gin.Context) {
@@ -38,6 +40,7 @@ func handleGetCronJob(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	result.AddClusterSummaries()
 	common.Success(c, result)
 }
 
@@ -79,4 +82,7 @@ func init() {
 	r.GET("/cronjob/:namespace", handleGetCronJob)
 	r.GET("/cronjob/:namespace/:statefulset", handleGetCronJobDetail)
 	r.GET("/cronjob/:namespace/:statefulset/event", handleGetCronJobEvents)
+	r.POST("/cronjob", resourcetemplate.HandleCreate(template.CronJobKind))
+	r.PUT("/cronjob/:namespace/:statefulset", resourcetemplate.HandleUpdate(template.CronJobKind, "statefulset"))
+	r.DELETE("/cronjob/:namespace/:statefulset", resourcetemplate.HandleDelete(template.CronJobKind, "statefulset"))
 }
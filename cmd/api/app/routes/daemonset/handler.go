@@ -20,9 +20,11 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/daemonset"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetDaemonset(c *gin.Context) {
@@ -38,6 +40,7 @@ func handleGetDaemonset(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	result.AddClusterSummaries()
 	common.Success(c, result)
 }
 
@@ -79,4 +82,7 @@ func init() {
 	r.GET("/daemonset/:namespace", handleGetDaemonset)
 	r.GET("/daemonset/:namespace/:statefulset", handleGetDaemonsetDetail)
 	r.GET("/daemonset/:namespace/:statefulset/event", handleGetDaemonsetEvents)
+	r.POST("/daemonset", resourcetemplate.HandleCreate(template.DaemonSetKind))
+	r.PUT("/daemonset/:namespace/:statefulset", resourcetemplate.HandleUpdate(template.DaemonSetKind, "statefulset"))
+	r.DELETE("/daemonset/:namespace/:statefulset", resourcetemplate.HandleDelete(template.DaemonSetKind, "statefulset"))
 }
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+	"github.com/karmada-io/dashboard/pkg/resource/deployment"
+	"github.com/karmada-io/dashboard/pkg/templaterevision"
+)
+
+func deploymentRef(c *gin.Context) templaterevision.WorkloadRef {
+	return templaterevision.WorkloadRef{
+		Kind:      "Deployment",
+		Namespace: c.Param("namespace"),
+		Name:      c.Param("deployment"),
+	}
+}
+
+func handleScaleDeployment(c *gin.Context) {
+	scaleRequest := new(v1.ScaleDeploymentRequest)
+	if err := c.ShouldBindJSON(scaleRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	ref := deploymentRef(c)
+	result, err := deployment.ScaleDeployment(c, k8sClient, karmadaClient, ref.Namespace, ref.Name, *scaleRequest.Replicas)
+	if err != nil {
+		klog.ErrorS(err, "ScaleDeployment failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRestartDeployment(c *gin.Context) {
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	ref := deploymentRef(c)
+	current, err := k8sClient.AppsV1().Deployments(ref.Namespace).Get(c, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	configmapstore.LogFailure(templaterevision.EnsureBaseline(c, ref, current.Spec.Template), "Failed to record baseline revision", "kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name)
+	result, err := deployment.RestartDeployment(c, k8sClient, karmadaClient, ref.Namespace, ref.Name)
+	if err != nil {
+		klog.ErrorS(err, "RestartDeployment failed")
+		common.Fail(c, err)
+		return
+	}
+	_, err = templaterevision.RecordRevision(c, ref, auth.GetCurrentUserName(c), result.Deployment.Spec.Template)
+	configmapstore.LogFailure(err, "Failed to record revision", "kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name)
+	common.Success(c, result)
+}
+
+func handlePauseDeployment(c *gin.Context) {
+	setDeploymentPaused(c, true)
+}
+
+func handleResumeDeployment(c *gin.Context) {
+	setDeploymentPaused(c, false)
+}
+
+func setDeploymentPaused(c *gin.Context, paused bool) {
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	ref := deploymentRef(c)
+	result, err := deployment.PauseDeployment(c, k8sClient, karmadaClient, ref.Namespace, ref.Name, paused)
+	if err != nil {
+		klog.ErrorS(err, "PauseDeployment failed", "paused", paused)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetDeploymentRevisions(c *gin.Context) {
+	result, err := templaterevision.ListRevisions(c, deploymentRef(c))
+	if err != nil {
+		klog.ErrorS(err, "ListRevisions failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRollbackDeployment(c *gin.Context) {
+	revision, err := strconv.ParseInt(c.Param("revision"), 10, 64)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	ref := deploymentRef(c)
+	target, err := templaterevision.GetRevision(c, ref, revision)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := deployment.RollbackDeployment(c, k8sClient, karmadaClient, ref.Namespace, ref.Name, target.Template)
+	if err != nil {
+		klog.ErrorS(err, "RollbackDeployment failed", "revision", revision)
+		common.Fail(c, err)
+		return
+	}
+	_, err = templaterevision.RecordRevision(c, ref, auth.GetCurrentUserName(c), result.Deployment.Spec.Template)
+	configmapstore.LogFailure(err, "Failed to record revision", "kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name)
+	common.Success(c, result)
+}
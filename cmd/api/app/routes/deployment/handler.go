@@ -26,11 +26,14 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
 	"github.com/karmada-io/dashboard/pkg/resource/deployment"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
+	"github.com/karmada-io/dashboard/pkg/templaterevision"
 )
 
 func handlerCreateDeployment(c *gin.Context) {
@@ -64,6 +67,12 @@ func handlerCreateDeployment(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	_, err = templaterevision.RecordRevision(c, templaterevision.WorkloadRef{
+		Kind:      "Deployment",
+		Namespace: result.Namespace,
+		Name:      result.Name,
+	}, auth.GetCurrentUserName(c), result.Spec.Template)
+	configmapstore.LogFailure(err, "Failed to record revision", "kind", "Deployment", "namespace", result.Namespace, "name", result.Name)
 	common.Success(c, result)
 }
 
@@ -80,6 +89,7 @@ func handleGetDeployments(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	result.AddClusterSummaries()
 	common.Success(c, result)
 }
 
@@ -122,4 +132,10 @@ func init() {
 	r.GET("/deployment/:namespace/:deployment", handleGetDeploymentDetail)
 	r.GET("/deployment/:namespace/:deployment/event", handleGetDeploymentEvents)
 	r.POST("/deployment", handlerCreateDeployment)
+	r.PUT("/deployment/:namespace/:deployment/scale", handleScaleDeployment)
+	r.PUT("/deployment/:namespace/:deployment/restart", handleRestartDeployment)
+	r.PUT("/deployment/:namespace/:deployment/pause", handlePauseDeployment)
+	r.PUT("/deployment/:namespace/:deployment/resume", handleResumeDeployment)
+	r.GET("/deployment/:namespace/:deployment/revision", handleGetDeploymentRevisions)
+	r.POST("/deployment/:namespace/:deployment/revision/:revision/rollback", handleRollbackDeployment)
 }
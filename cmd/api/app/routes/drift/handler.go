@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/drift"
+)
+
+func handleGetWorkloadDrift(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	kind := c.Param("kind")
+	name := c.Param("name")
+	result, err := drift.CheckWorkload(c, karmadaClient, namespace, kind, name)
+	if err != nil {
+		klog.ErrorS(err, "CheckWorkload failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleCheckNamespaceDrift(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	result, err := drift.CheckNamespace(c, karmadaClient, namespace)
+	if err != nil {
+		klog.ErrorS(err, "CheckNamespace failed")
+		common.Fail(c, err)
+		return
+	}
+	drift.RecordSummary(result)
+	common.Success(c, result)
+}
+
+func handleGetNamespaceDriftSummary(c *gin.Context) {
+	namespace := c.Param("namespace")
+	result, ok := drift.LastSummary(namespace)
+	if !ok {
+		common.Fail(c, apierrors.NewNotFound(schema.GroupResource{Resource: "driftsummary"}, namespace))
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/drift/workload/:namespace/:kind/:name", handleGetWorkloadDrift)
+	r.POST("/drift/namespace/:namespace", handleCheckNamespaceDrift)
+	r.GET("/drift/namespace/:namespace", handleGetNamespaceDriftSummary)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/event"
+)
+
+// handleGetWorkloadTimeline returns the events of a workload merged from the control plane and
+// the member clusters.
+func handleGetWorkloadTimeline(c *gin.Context) {
+	eventsRequest := new(v1.WorkloadEventsRequest)
+	if err := c.ShouldBindQuery(eventsRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := event.GetWorkloadTimeline(c, k8sClient, karmadaClient,
+		c.Param("namespace"), c.Param("kind"), c.Param("name"), eventsRequest.WarningsOnly, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetWorkloadTimeline failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/event/:namespace/:kind/:name", handleGetWorkloadTimeline)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/federatedhpa"
+)
+
+func handleGetFederatedHPAList(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := federatedhpa.GetFederatedHPAList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetFederatedHPAList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetFederatedHPADetail(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := federatedhpa.GetFederatedHPADetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetFederatedHPADetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostFederatedHPA(c *gin.Context) {
+	req := new(v1.PostFederatedHPARequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := federatedhpa.CreateFederatedHPA(c, karmadaClient, req.Namespace, req.Content)
+	if err != nil {
+		klog.ErrorS(err, "CreateFederatedHPA failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePutFederatedHPA(c *gin.Context) {
+	req := new(v1.PutFederatedHPARequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := federatedhpa.UpdateFederatedHPA(c, karmadaClient, c.Param("namespace"), c.Param("name"), req.Content)
+	if err != nil {
+		klog.ErrorS(err, "UpdateFederatedHPA failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/federatedhpa", handleGetFederatedHPAList)
+	r.GET("/federatedhpa/:namespace", handleGetFederatedHPAList)
+	r.GET("/federatedhpa/:namespace/:name", handleGetFederatedHPADetail)
+	r.POST("/federatedhpa", handlePostFederatedHPA)
+	r.PUT("/federatedhpa/:namespace/:name", handlePutFederatedHPA)
+}
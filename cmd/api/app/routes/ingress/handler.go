@@ -20,8 +20,10 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/ingress"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetIngress(c *gin.Context) {
@@ -61,4 +63,7 @@ func init() {
 	r.GET("/ingress", handleGetIngress)
 	r.GET("/ingress/:namespace", handleGetIngress)
 	r.GET("/ingress/:namespace/:service", handleGetIngressDetail)
+	r.POST("/ingress", resourcetemplate.HandleCreate(template.IngressKind))
+	r.PUT("/ingress/:namespace/:service", resourcetemplate.HandleUpdate(template.IngressKind, "service"))
+	r.DELETE("/ingress/:namespace/:service", resourcetemplate.HandleDelete(template.IngressKind, "service"))
 }
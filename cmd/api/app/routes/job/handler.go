@@ -20,9 +20,11 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
 	"github.com/karmada-io/dashboard/pkg/resource/job"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetJob(c *gin.Context) {
@@ -38,6 +40,7 @@ func handleGetJob(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	result.AddClusterSummaries()
 	common.Success(c, result)
 }
 
@@ -79,4 +82,7 @@ func init() {
 	r.GET("/job/:namespace", handleGetJob)
 	r.GET("/job/:namespace/:statefulset", handleGetJobDetail)
 	r.GET("/job/:namespace/:statefulset/event", handleGetJobEvents)
+	r.POST("/job", resourcetemplate.HandleCreate(template.JobKind))
+	r.PUT("/job/:namespace/:statefulset", resourcetemplate.HandleUpdate(template.JobKind, "statefulset"))
+	r.DELETE("/job/:namespace/:statefulset", resourcetemplate.HandleDelete(template.JobKind, "statefulset"))
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/logs"
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+// streamError is sent as an error event of the stream.
+type streamError struct {
+	Source  *logs.Source `json:"source,omitempty"`
+	Message string       `json:"message"`
+}
+
+// sendEvent writes an SSE event and flushes it to the client.
+func sendEvent(c *gin.Context, name string, data interface{}) {
+	c.SSEvent(name, data)
+	c.Writer.Flush()
+}
+
+func handleGetWorkloadLogs(c *gin.Context) {
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	kind := c.Param("kind")
+
+	logsRequest := new(v1.WorkloadLogsRequest)
+	if err := c.ShouldBindQuery(logsRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts := logs.Options{
+		SinceSeconds: logsRequest.SinceSeconds,
+		TailLines:    logsRequest.TailLines,
+		Previous:     logsRequest.Previous,
+		Follow:       logsRequest.Follow == nil || *logsRequest.Follow,
+	}
+	if logsRequest.Filter != "" {
+		filter, err := regexp.Compile(logsRequest.Filter)
+		if err != nil {
+			common.Fail(c, fmt.Errorf("invalid filter: %w", err))
+			return
+		}
+		opts.Filter = filter
+	}
+
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	clusterPods, nonCriticalErrors, err := topology.GetWorkloadPods(c.Request.Context(), k8sClient, namespace, name, kind)
+	if err != nil {
+		klog.ErrorS(err, "GetWorkloadPods failed")
+		common.Fail(c, err)
+		return
+	}
+	sources := logs.Sources(clusterPods, logsRequest.Container)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	for _, e := range nonCriticalErrors {
+		sendEvent(c, "error", streamError{Message: e.Error()})
+	}
+	if len(sources) > logs.MaxSources {
+		sendEvent(c, "error", streamError{Message: fmt.Sprintf(
+			"%d containers match, only the first %d are streamed, use the container filter to narrow them down",
+			len(sources), logs.MaxSources)})
+		sources = sources[:logs.MaxSources]
+	}
+	sendEvent(c, "sources", sources)
+
+	err = logs.Stream(c.Request.Context(), sources, opts,
+		func(line logs.Line) error {
+			sendEvent(c, "log", line)
+			return c.Request.Context().Err()
+		},
+		func(source logs.Source, err error) {
+			sendEvent(c, "error", streamError{Source: &source, Message: err.Error()})
+		})
+	if err != nil {
+		klog.V(4).InfoS("Workload log stream ended", "namespace", namespace, "kind", kind, "name", name, "err", err)
+		return
+	}
+	sendEvent(c, "end", gin.H{})
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/logs/:namespace/:kind/:name", handleGetWorkloadLogs)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raw
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/raw"
+)
+
+// setWarningHeaders adds the warnings as HTTP warning headers, the way the apiserver reports them.
+func setWarningHeaders(c *gin.Context, warnings []string) {
+	for _, warning := range warnings {
+		c.Writer.Header().Add("Warning", fmt.Sprintf("299 - %q", warning))
+	}
+}
+
+func readObject(c *gin.Context) (*unstructured.Unstructured, error) {
+	bytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	if err = obj.UnmarshalJSON(bytes); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func handleGetMemberResourceList(c *gin.Context) {
+	verber, err := client.MemberVerberClient(c.Request, c.Param("clustername"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := raw.GetResourceList(verber, c.Param("kind"), nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list member resources", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetMemberResource(c *gin.Context) {
+	verber, err := client.MemberVerberClient(c.Request, c.Param("clustername"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	result, err := verber.Get(c.Param("kind"), c.Param("namespace"), c.Param("name"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to get member resource", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	setWarningHeaders(c, raw.NewMutationResult(result).Warnings)
+	common.Success(c, result)
+}
+
+func handlePutMemberResource(c *gin.Context) {
+	verber, err := client.MemberVerberClient(c.Request, c.Param("clustername"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	obj, err := readObject(c)
+	if err != nil {
+		klog.ErrorS(err, "Failed to read request body")
+		common.Fail(c, err)
+		return
+	}
+	current, err := verber.Get(c.Param("kind"), c.Param("namespace"), c.Param("name"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to get member resource", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	if err = verber.Update(obj); err != nil {
+		klog.ErrorS(err, "Failed to update member resource", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	result := raw.NewMutationResult(current)
+	setWarningHeaders(c, result.Warnings)
+	common.Success(c, result)
+}
+
+func handleCreateMemberResource(c *gin.Context) {
+	verber, err := client.MemberVerberClient(c.Request, c.Param("clustername"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	obj, err := readObject(c)
+	if err != nil {
+		klog.ErrorS(err, "Failed to read request body")
+		common.Fail(c, err)
+		return
+	}
+	created, err := verber.Create(obj)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create member resource", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, raw.NewMutationResult(created))
+}
+
+func handleDeleteMemberResource(c *gin.Context) {
+	verber, err := client.MemberVerberClient(c.Request, c.Param("clustername"))
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	kind, namespace, name := c.Param("kind"), c.Param("namespace"), c.Param("name")
+	current, err := verber.Get(kind, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get member resource", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	if err = verber.Delete(kind, namespace, name, c.Query("deleteNow") == "true"); err != nil {
+		klog.ErrorS(err, "Failed to delete member resource", "cluster", c.Param("clustername"))
+		common.Fail(c, err)
+		return
+	}
+	result := raw.NewMutationResult(current)
+	setWarningHeaders(c, result.Warnings)
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.MemberV1()
+	r.GET("/_raw/:kind", handleGetMemberResourceList)
+	r.GET("/_raw/:kind/namespace/:namespace", handleGetMemberResourceList)
+
+	r.DELETE("/_raw/:kind/namespace/:namespace/name/:name", handleDeleteMemberResource)
+	r.GET("/_raw/:kind/namespace/:namespace/name/:name", handleGetMemberResource)
+	r.PUT("/_raw/:kind/namespace/:namespace/name/:name", handlePutMemberResource)
+	r.POST("/_raw/:kind/namespace/:namespace/name/:name", handleCreateMemberResource)
+
+	// Verber (non-namespaced)
+	r.DELETE("/_raw/:kind/name/:name", handleDeleteMemberResource)
+	r.GET("/_raw/:kind/name/:name", handleGetMemberResource)
+	r.PUT("/_raw/:kind/name/:name", handlePutMemberResource)
+	r.POST("/_raw/:kind/name/:name", handleCreateMemberResource)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/migration"
+)
+
+const defaultMigrationTimeout = 10 * time.Minute
+
+func handleMigrateWorkload(c *gin.Context) {
+	migrateRequest := new(v1.MigrateWorkloadRequest)
+	if err := c.ShouldBindJSON(migrateRequest); err != nil {
+		klog.ErrorS(err, "Could not read migrate workload request")
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts := migration.Options{
+		Namespace:     c.Param("namespace"),
+		Kind:          c.Param("kind"),
+		Name:          c.Param("name"),
+		SourceCluster: migrateRequest.SourceCluster,
+		TargetCluster: migrateRequest.TargetCluster,
+		Surge:         migrateRequest.Surge,
+		Timeout:       time.Duration(migrateRequest.TimeoutSeconds) * time.Second,
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultMigrationTimeout
+	}
+	m, err := migration.New(c, karmadaClient, k8sClient, opts)
+	if err != nil {
+		klog.ErrorS(err, "Create migration failed")
+		common.Fail(c, err)
+		return
+	}
+	go func() {
+		op := m.Tracker().Snapshot()
+		if err := m.Run(); err != nil {
+			klog.ErrorS(err, "Operation failed", "operation", op.ID, "type", op.Type, "target", op.Target)
+			return
+		}
+		klog.InfoS("Operation succeeded", "operation", op.ID, "type", op.Type, "target", op.Target)
+	}()
+	common.Success(c, m.Tracker().Snapshot())
+}
+
+// handleControlMigration returns a handler applying control to the running migration, its progress
+// is polled with GET /operations/:id like every other operation.
+func handleControlMigration(control func(m *migration.Migration)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		m, err := migration.Get(c.Param("id"))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		control(m)
+		common.Success(c, m.Tracker().Snapshot())
+	}
+}
+
+func init() {
+	r := router.V1()
+	r.POST("/migration/:namespace/:kind/:name", handleMigrateWorkload)
+	r.PUT("/migration/operations/:id/pause", handleControlMigration((*migration.Migration).Pause))
+	r.PUT("/migration/operations/:id/resume", handleControlMigration((*migration.Migration).Resume))
+	r.PUT("/migration/operations/:id/abort", handleControlMigration((*migration.Migration).Abort))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/multiclusteringress"
+)
+
+func handleGetMultiClusterIngressList(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := multiclusteringress.GetMultiClusterIngressList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetMultiClusterIngressList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetMultiClusterIngressDetail(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := multiclusteringress.GetMultiClusterIngressDetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetMultiClusterIngressDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostMultiClusterIngress(c *gin.Context) {
+	req := new(v1.PostMultiClusterServiceRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := multiclusteringress.CreateMultiClusterIngress(c, karmadaClient, req.Namespace, req.Content)
+	if err != nil {
+		klog.ErrorS(err, "CreateMultiClusterIngress failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePutMultiClusterIngress(c *gin.Context) {
+	req := new(v1.PutMultiClusterServiceRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := multiclusteringress.UpdateMultiClusterIngress(c, karmadaClient, c.Param("namespace"), c.Param("name"), req.Content)
+	if err != nil {
+		klog.ErrorS(err, "UpdateMultiClusterIngress failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleDeleteMultiClusterIngress(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err := multiclusteringress.DeleteMultiClusterIngress(c, karmadaClient, c.Param("namespace"), c.Param("name")); err != nil {
+		klog.ErrorS(err, "DeleteMultiClusterIngress failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/multiclusteringress", handleGetMultiClusterIngressList)
+	r.GET("/multiclusteringress/:namespace", handleGetMultiClusterIngressList)
+	r.GET("/multiclusteringress/:namespace/:name", handleGetMultiClusterIngressDetail)
+	r.POST("/multiclusteringress", handlePostMultiClusterIngress)
+	r.PUT("/multiclusteringress/:namespace/:name", handlePutMultiClusterIngress)
+	r.DELETE("/multiclusteringress/:namespace/:name", handleDeleteMultiClusterIngress)
+}
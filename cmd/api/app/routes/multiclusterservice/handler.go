@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/multiclusterservice"
+)
+
+func handleGetMultiClusterServiceList(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := multiclusterservice.GetMultiClusterServiceList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetMultiClusterServiceList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetMultiClusterServiceDetail(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := multiclusterservice.GetMultiClusterServiceDetail(c, karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetMultiClusterServiceDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostMultiClusterServiceFromService(c *gin.Context) {
+	req := new(v1.MultiClusterServiceFromServiceRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := multiclusterservice.FromService(c, k8sClient, karmadaClient, c.Param("namespace"), c.Param("name"),
+		multiclusterservice.FromServiceOptions{
+			Types:            req.Types,
+			ProviderClusters: req.ProviderClusters,
+			ConsumerClusters: req.ConsumerClusters,
+			DryRun:           req.DryRun,
+		})
+	if err != nil {
+		klog.ErrorS(err, "Create MultiClusterService from Service failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostMultiClusterService(c *gin.Context) {
+	req := new(v1.PostMultiClusterServiceRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := multiclusterservice.CreateMultiClusterService(c, karmadaClient, req.Namespace, req.Content)
+	if err != nil {
+		klog.ErrorS(err, "CreateMultiClusterService failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePutMultiClusterService(c *gin.Context) {
+	req := new(v1.PutMultiClusterServiceRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := multiclusterservice.UpdateMultiClusterService(c, karmadaClient, c.Param("namespace"), c.Param("name"), req.Content)
+	if err != nil {
+		klog.ErrorS(err, "UpdateMultiClusterService failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleDeleteMultiClusterService(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err := multiclusterservice.DeleteMultiClusterService(c, karmadaClient, c.Param("namespace"), c.Param("name")); err != nil {
+		klog.ErrorS(err, "DeleteMultiClusterService failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/multiclusterservice", handleGetMultiClusterServiceList)
+	r.GET("/multiclusterservice/:namespace", handleGetMultiClusterServiceList)
+	r.GET("/multiclusterservice/:namespace/:name", handleGetMultiClusterServiceDetail)
+	r.POST("/multiclusterservice/:namespace/:name/fromservice", handlePostMultiClusterServiceFromService)
+	r.POST("/multiclusterservice", handlePostMultiClusterService)
+	r.PUT("/multiclusterservice/:namespace/:name", handlePutMultiClusterService)
+	r.DELETE("/multiclusterservice/:namespace/:name", handleDeleteMultiClusterService)
+}
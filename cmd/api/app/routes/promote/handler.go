@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/promote"
+)
+
+func parsePromoteRequest(c *gin.Context) (*v1.PromoteRequest, []promote.ObjectRef, error) {
+	promoteRequest := new(v1.PromoteRequest)
+	if err := c.ShouldBindJSON(promoteRequest); err != nil {
+		return nil, nil, err
+	}
+	refs := make([]promote.ObjectRef, 0, len(promoteRequest.Resources))
+	for _, resource := range promoteRequest.Resources {
+		refs = append(refs, promote.ObjectRef{Kind: resource.Kind, Namespace: resource.Namespace, Name: resource.Name})
+	}
+	return promoteRequest, refs, nil
+}
+
+// handlePlanPromotion lists the objects a promotion would adopt, with their dependencies.
+func handlePlanPromotion(c *gin.Context) {
+	cluster := c.Param("clustername")
+	promoteRequest, refs, err := parsePromoteRequest(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	memberVerber, err := client.MemberVerberClient(c.Request, cluster)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	karmadaVerber, err := client.VerberClient(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	memberClient := client.InClusterClientForMemberCluster(cluster)
+	result, err := promote.Plan(c, memberClient, memberVerber, karmadaVerber, refs, promoteRequest.IncludeDependencies)
+	if err != nil {
+		klog.ErrorS(err, "Plan promotion failed", "cluster", cluster)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePromote(c *gin.Context) {
+	cluster := c.Param("clustername")
+	promoteRequest, refs, err := parsePromoteRequest(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	memberVerber, err := client.MemberVerberClient(c.Request, cluster)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	karmadaVerber, err := client.VerberClient(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	var skipped []promote.ObjectRef
+	if promoteRequest.IncludeDependencies {
+		memberClient := client.InClusterClientForMemberCluster(cluster)
+		candidates, err := promote.Plan(c, memberClient, memberVerber, karmadaVerber, refs, true)
+		if err != nil {
+			klog.ErrorS(err, "Plan promotion failed", "cluster", cluster)
+			common.Fail(c, err)
+			return
+		}
+		refs, skipped = promote.PromotionRefs(candidates)
+	}
+	result, err := promote.Promote(c, k8sClient, karmadaClient, memberVerber, karmadaVerber, cluster, refs)
+	if err != nil {
+		klog.ErrorS(err, "Promote failed", "cluster", cluster)
+		common.Fail(c, err)
+		return
+	}
+	if skipped != nil {
+		result.Skipped = skipped
+	}
+	common.Success(c, result)
+}
+
+func handleVerifyPromotion(c *gin.Context) {
+	cluster := c.Param("clustername")
+	verifyRequest := new(v1.PromoteVerifyRequest)
+	if err := c.ShouldBindQuery(verifyRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	memberVerber, err := client.MemberVerberClient(c.Request, cluster)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init member VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	memberClient := client.InClusterClientForMemberCluster(cluster)
+	ref := promote.ObjectRef{Kind: c.Param("kind"), Namespace: c.Param("namespace"), Name: c.Param("name")}
+	result, err := promote.Verify(c, karmadaClient, memberClient, memberVerber, cluster, ref, verifyRequest.Since)
+	if err != nil {
+		klog.ErrorS(err, "Verify promotion failed", "cluster", cluster)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	// Member objects are browsed with the generic member routes, /member/:clustername/_raw/...
+	r := router.V1()
+	r.POST("/promote/:clustername/plan", handlePlanPromotion)
+	r.POST("/promote/:clustername", handlePromote)
+	r.GET("/promote/:clustername/verify/:namespace/:kind/:name", handleVerifyPromotion)
+}
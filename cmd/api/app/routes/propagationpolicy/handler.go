@@ -27,9 +27,12 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+	"github.com/karmada-io/dashboard/pkg/policyrevision"
 	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
 )
 
@@ -87,6 +90,8 @@ func handlePostPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	var revisionRef policyrevision.PolicyRef
+	var revisionSpec v1alpha1.PropagationSpec
 	if propagationpolicyRequest.IsClusterScope {
 		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
@@ -94,7 +99,12 @@ func handlePostPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterpropagationPolicy, metav1.CreateOptions{})
+		var created *v1alpha1.ClusterPropagationPolicy
+		created, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterpropagationPolicy, metav1.CreateOptions{})
+		if err == nil {
+			revisionRef = policyrevision.PolicyRef{Kind: policyrevision.ClusterPropagationPolicyKind, Name: created.Name}
+			revisionSpec = created.Spec
+		}
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
@@ -102,13 +112,20 @@ func handlePostPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
+		var created *v1alpha1.PropagationPolicy
+		created, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
+		if err == nil {
+			revisionRef = policyrevision.PolicyRef{Kind: policyrevision.PropagationPolicyKind, Namespace: created.Namespace, Name: created.Name}
+			revisionSpec = created.Spec
+		}
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to create PropagationPolicy")
 		common.Fail(c, err)
 		return
 	}
+	_, err = policyrevision.RecordRevision(c, revisionRef, auth.GetCurrentUserName(c), revisionSpec)
+	configmapstore.LogFailure(err, "Failed to record revision", "kind", revisionRef.Kind, "namespace", revisionRef.Namespace, "name", revisionRef.Name)
 	common.Success(c, "ok")
 }
 func handlePutPropagationPolicy(c *gin.Context) {
@@ -124,6 +141,8 @@ func handlePutPropagationPolicy(c *gin.Context) {
 		return
 	}
 	// todo check pp exist
+	var revisionRef policyrevision.PolicyRef
+	var revisionSpec v1alpha1.PropagationSpec
 	if propagationpolicyRequest.IsClusterScope {
 		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
@@ -131,7 +150,15 @@ func handlePutPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, &clusterpropagationPolicy, metav1.UpdateOptions{})
+		revisionRef = policyrevision.PolicyRef{Kind: policyrevision.ClusterPropagationPolicyKind, Name: clusterpropagationPolicy.Name}
+		if old, getErr := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, clusterpropagationPolicy.Name, metav1.GetOptions{}); getErr == nil {
+			configmapstore.LogFailure(policyrevision.EnsureBaseline(c, revisionRef, old.Spec), "Failed to record baseline revision", "kind", revisionRef.Kind, "name", revisionRef.Name)
+		}
+		var updated *v1alpha1.ClusterPropagationPolicy
+		updated, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, &clusterpropagationPolicy, metav1.UpdateOptions{})
+		if err == nil {
+			revisionSpec = updated.Spec
+		}
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
@@ -145,7 +172,14 @@ func handlePutPropagationPolicy(c *gin.Context) {
 			// only spec can be updated
 			propagationPolicy.TypeMeta = oldPropagationPolicy.TypeMeta
 			propagationPolicy.ObjectMeta = oldPropagationPolicy.ObjectMeta
-			_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Update(ctx, &propagationPolicy, metav1.UpdateOptions{})
+			revisionRef = policyrevision.PolicyRef{Kind: policyrevision.PropagationPolicyKind, Namespace: oldPropagationPolicy.Namespace, Name: oldPropagationPolicy.Name}
+			configmapstore.LogFailure(policyrevision.EnsureBaseline(c, revisionRef, oldPropagationPolicy.Spec), "Failed to record baseline revision", "kind", revisionRef.Kind, "namespace", revisionRef.Namespace, "name", revisionRef.Name)
+			var updated *v1alpha1.PropagationPolicy
+			updated, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Update(ctx, &propagationPolicy, metav1.UpdateOptions{})
+			if err == nil {
+				// record the spec defaulted by the server, like the baseline, so diffs only show real changes
+				revisionSpec = updated.Spec
+			}
 		}
 	}
 	if err != nil {
@@ -153,6 +187,8 @@ func handlePutPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	_, err = policyrevision.RecordRevision(c, revisionRef, auth.GetCurrentUserName(c), revisionSpec)
+	configmapstore.LogFailure(err, "Failed to record revision", "kind", revisionRef.Kind, "namespace", revisionRef.Namespace, "name", revisionRef.Name)
 	common.Success(c, "ok")
 }
 func handleDeletePropagationPolicy(c *gin.Context) {
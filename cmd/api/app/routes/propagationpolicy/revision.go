@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/policyrevision"
+)
+
+func propagationPolicyRef(c *gin.Context) policyrevision.PolicyRef {
+	return policyrevision.PolicyRef{
+		Kind:      policyrevision.PropagationPolicyKind,
+		Namespace: c.Param("namespace"),
+		Name:      c.Param("propagationPolicyName"),
+	}
+}
+
+func handleGetPropagationPolicyRevisions(c *gin.Context) {
+	result, err := policyrevision.ListRevisions(c, propagationPolicyRef(c))
+	if err != nil {
+		klog.ErrorS(err, "ListRevisions failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetPropagationPolicyRevisionDiff(c *gin.Context) {
+	diffRequest := new(v1.DiffPropagationPolicyRevisionsRequest)
+	if err := c.ShouldBindQuery(diffRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := policyrevision.DiffRevisions(c, propagationPolicyRef(c), diffRequest.From, diffRequest.To)
+	if err != nil {
+		klog.ErrorS(err, "DiffRevisions failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRollbackPropagationPolicy(c *gin.Context) {
+	rollbackRequest := new(v1.RollbackPropagationPolicyRequest)
+	if err := c.ShouldBindQuery(rollbackRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	revision, err := strconv.ParseInt(c.Param("revision"), 10, 64)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := policyrevision.Rollback(c, karmadaClient, propagationPolicyRef(c), revision, auth.GetCurrentUserName(c), rollbackRequest.DryRun)
+	if err != nil {
+		klog.ErrorS(err, "Rollback PropagationPolicy failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/propagationpolicy/namespace/:namespace/:propagationPolicyName/revision", handleGetPropagationPolicyRevisions)
+	r.GET("/propagationpolicy/namespace/:namespace/:propagationPolicyName/revision/diff", handleGetPropagationPolicyRevisionDiff)
+	r.POST("/propagationpolicy/namespace/:namespace/:propagationPolicyName/revision/:revision/rollback", handleRollbackPropagationPolicy)
+}
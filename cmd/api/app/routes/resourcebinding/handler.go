@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/resourcebinding"
+)
+
+func handleGetResourceBindingList(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	namespace := common.ParseNamespacePathParameter(c)
+	result, err := resourcebinding.GetResourceBindingList(karmadaClient, namespace, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetResourceBindingList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetResourceBindingDetail(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("resourceBindingName")
+	result, err := resourcebinding.GetResourceBindingDetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetResourceBindingDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/resourcebinding", handleGetResourceBindingList)
+	r.GET("/resourcebinding/:namespace", handleGetResourceBindingList)
+	r.GET("/resourcebinding/namespace/:namespace/:resourceBindingName", handleGetResourceBindingDetail)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcetemplate provides the create, update and delete handlers shared by the routes
+// of the typed resource templates.
+package resourcetemplate
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
+)
+
+func policyOptions(policy *v1.InlinePropagationPolicy) *template.PolicyOptions {
+	if policy == nil {
+		return nil
+	}
+	return &template.PolicyOptions{
+		Name:         policy.Name,
+		ClusterNames: policy.ClusterNames,
+		Content:      policy.Content,
+	}
+}
+
+// HandleCreate returns the handler creating resource templates of the kind.
+func HandleCreate(kind template.Kind) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		createRequest := new(v1.CreateResourceTemplateRequest)
+		if err := c.ShouldBindJSON(createRequest); err != nil {
+			common.Fail(c, err)
+			return
+		}
+		if createRequest.Namespace == "" {
+			createRequest.Namespace = "default"
+		}
+		k8sClient, err := router.GetKubeClientFromContext(c)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		karmadaClient, err := router.GetKarmadaClientFromContext(c)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		result, err := template.Create(c, k8sClient, karmadaClient, kind, createRequest.Namespace, createRequest.Name,
+			createRequest.Content, createRequest.Fields, policyOptions(createRequest.PropagationPolicy))
+		if err != nil {
+			klog.ErrorS(err, "Failed to create resource template", "kind", kind)
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
+	}
+}
+
+// HandleUpdate returns the handler updating resource templates of the kind, nameParam is the path
+// parameter holding the name.
+func HandleUpdate(kind template.Kind, nameParam string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		updateRequest := new(v1.UpdateResourceTemplateRequest)
+		if err := c.ShouldBindJSON(updateRequest); err != nil {
+			common.Fail(c, err)
+			return
+		}
+		k8sClient, err := router.GetKubeClientFromContext(c)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		karmadaClient, err := router.GetKarmadaClientFromContext(c)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		result, err := template.Update(c, k8sClient, karmadaClient, kind, c.Param("namespace"), c.Param(nameParam),
+			updateRequest.Content, updateRequest.Fields, policyOptions(updateRequest.PropagationPolicy))
+		if err != nil {
+			klog.ErrorS(err, "Failed to update resource template", "kind", kind)
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
+	}
+}
+
+// HandleDelete returns the handler deleting resource templates of the kind, nameParam is the path
+// parameter holding the name.
+func HandleDelete(kind template.Kind, nameParam string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		k8sClient, err := router.GetKubeClientFromContext(c)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		if err = template.Delete(c, k8sClient, kind, c.Param("namespace"), c.Param(nameParam)); err != nil {
+			klog.ErrorS(err, "Failed to delete resource template", "kind", kind)
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, "ok")
+	}
+}
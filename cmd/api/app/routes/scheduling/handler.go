@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/resource/scheduling"
+)
+
+func handleGetSchedulingExplanation(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := scheduling.GetSchedulingExplanation(c, karmadaClient, c.Param("namespace"), c.Param("binding"))
+	if err != nil {
+		klog.ErrorS(err, "GetSchedulingExplanation failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostSchedulingSimulation(c *gin.Context) {
+	simulateRequest := new(v1.SimulateSchedulingRequest)
+	if err := c.ShouldBind(simulateRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := scheduling.Simulate(c, karmadaClient, &scheduling.SimulationInput{
+		Namespace:           simulateRequest.Namespace,
+		Binding:             simulateRequest.Binding,
+		Resource:            simulateRequest.Resource,
+		Placement:           simulateRequest.Placement,
+		Replicas:            simulateRequest.Replicas,
+		ReplicaRequirements: simulateRequest.ReplicaRequirements,
+		ClusterOverrides:    simulateRequest.ClusterOverrides,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Simulate scheduling failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/scheduling/:namespace/:binding/explain", handleGetSchedulingExplanation)
+	r.POST("/scheduling/simulate", handlePostSchedulingSimulation)
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/secret"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetSecrets(c *gin.Context) {
@@ -60,4 +62,7 @@ func init() {
 	r.GET("/secret", handleGetSecrets)
 	r.GET("/secret/:namespace", handleGetSecrets)
 	r.GET("/secret/:namespace/:service", handleGetSecretDetail)
+	r.POST("/secret", resourcetemplate.HandleCreate(template.SecretKind))
+	r.PUT("/secret/:namespace/:service", resourcetemplate.HandleUpdate(template.SecretKind, "service"))
+	r.DELETE("/secret/:namespace/:service", resourcetemplate.HandleDelete(template.SecretKind, "service"))
 }
@@ -20,8 +20,10 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/service"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetServices(c *gin.Context) {
@@ -79,4 +81,7 @@ func init() {
 	r.GET("/service/:namespace", handleGetServices)
 	r.GET("/service/:namespace/:service", handleGetServiceDetail)
 	r.GET("/service/:namespace/:service/event", handleGetServiceEvents)
+	r.POST("/service", resourcetemplate.HandleCreate(template.ServiceKind))
+	r.PUT("/service/:namespace/:service", resourcetemplate.HandleUpdate(template.ServiceKind, "service"))
+	r.DELETE("/service/:namespace/:service", resourcetemplate.HandleDelete(template.ServiceKind, "service"))
 }
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceimport
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/serviceimport"
+)
+
+func handleGetServiceImportList(c *gin.Context) {
+	mcsClient, err := client.GetMCSClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := serviceimport.GetServiceImportList(mcsClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "GetServiceImportList failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetServiceImportDetail(c *gin.Context) {
+	mcsClient, err := client.GetMCSClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := serviceimport.GetServiceImportDetail(c, mcsClient, karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetServiceImportDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/serviceimport", handleGetServiceImportList)
+	r.GET("/serviceimport/:namespace", handleGetServiceImportList)
+	r.GET("/serviceimport/:namespace/:name", handleGetServiceImportDetail)
+}
@@ -20,9 +20,11 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/resourcetemplate"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
 	"github.com/karmada-io/dashboard/pkg/resource/statefulset"
+	"github.com/karmada-io/dashboard/pkg/resource/template"
 )
 
 func handleGetStatefulsets(c *gin.Context) {
@@ -38,6 +40,7 @@ func handleGetStatefulsets(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	result.AddClusterSummaries()
 	common.Success(c, result)
 }
 
@@ -79,4 +82,7 @@ func init() {
 	r.GET("/statefulset/:namespace", handleGetStatefulsets)
 	r.GET("/statefulset/:namespace/:statefulset", handleGetStatefulsetDetail)
 	r.GET("/statefulset/:namespace/:statefulset/event", handleGetStatefulsetEvents)
+	r.POST("/statefulset", resourcetemplate.HandleCreate(template.StatefulSetKind))
+	r.PUT("/statefulset/:namespace/:statefulset", resourcetemplate.HandleUpdate(template.StatefulSetKind, "statefulset"))
+	r.DELETE("/statefulset/:namespace/:statefulset", resourcetemplate.HandleDelete(template.StatefulSetKind, "statefulset"))
 }
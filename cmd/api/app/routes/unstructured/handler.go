@@ -26,8 +26,12 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+	"github.com/karmada-io/dashboard/pkg/resource/raw"
+	"github.com/karmada-io/dashboard/pkg/templaterevision"
 )
 
 func handleDeleteResource(c *gin.Context) {
@@ -104,14 +108,41 @@ func handlePutResource(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	kind := c.Param("kind")
+	ref, _, isWorkload := templaterevision.WorkloadTemplate(raw)
+	if isWorkload {
+		if current, ok := getUnstructured(verber, kind, ref.Namespace, ref.Name); ok {
+			_, template, _ := templaterevision.WorkloadTemplate(current)
+			configmapstore.LogFailure(templaterevision.EnsureBaseline(c, ref, template), "Failed to record baseline revision",
+				"kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name)
+		}
+	}
 	if err = verber.Update(raw); err != nil {
 		klog.ErrorS(err, "Failed to update resource")
 		common.Fail(c, err)
 		return
 	}
+	if isWorkload {
+		// record the template defaulted by the server, like the baseline
+		if updated, ok := getUnstructured(verber, kind, ref.Namespace, ref.Name); ok {
+			_, template, _ := templaterevision.WorkloadTemplate(updated)
+			_, err = templaterevision.RecordRevision(c, ref, auth.GetCurrentUserName(c), template)
+			configmapstore.LogFailure(err, "Failed to record revision", "kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name)
+		}
+	}
 	common.Success(c, "ok")
 }
 
+func getUnstructured(verber client.ResourceVerber, kind, namespace, name string) (*unstructured.Unstructured, bool) {
+	obj, err := verber.Get(kind, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get resource", "kind", kind, "namespace", namespace, "name", name)
+		return nil, false
+	}
+	result, ok := obj.(*unstructured.Unstructured)
+	return result, ok
+}
+
 func handleCreateResource(c *gin.Context) {
 	// todo double-check existence of target resources, if exist return directly.
 	verber, err := client.VerberClient(c.Request)
@@ -142,8 +173,28 @@ func handleCreateResource(c *gin.Context) {
 	common.Success(c, "ok")
 }
 
+func handleGetResourceList(c *gin.Context) {
+	verber, err := client.VerberClient(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := raw.GetResourceList(verber, c.Param("kind"), nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list resources")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
 func init() {
 	r := router.V1()
+	r.GET("/_raw/:kind", handleGetResourceList)
+	r.GET("/_raw/:kind/namespace/:namespace", handleGetResourceList)
 	r.DELETE("/_raw/:kind/namespace/:namespace/name/:name", handleDeleteResource)
 	r.GET("/_raw/:kind/namespace/:namespace/name/:name", handleGetResource)
 	r.PUT("/_raw/:kind/namespace/:namespace/name/:name", handlePutResource)
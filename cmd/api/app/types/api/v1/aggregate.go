@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// AggregateRequest defines the query structure for listing resources across member clusters.
+type AggregateRequest struct {
+	// ClusterSelector is a label selector of the clusters, every Ready cluster when empty.
+	ClusterSelector string `form:"clusterSelector"`
+	// Clusters is a comma separated list of cluster names narrowing the selection.
+	Clusters string `form:"clusters"`
+	// Concurrency is how many clusters are queried at the same time, 10 by default.
+	Concurrency int `form:"concurrency" binding:"omitempty,min=1,max=100"`
+	// TimeoutSeconds bounds the requests to a single cluster, 10 seconds by default.
+	TimeoutSeconds int `form:"timeoutSeconds" binding:"omitempty,min=1"`
+}
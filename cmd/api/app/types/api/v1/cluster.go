@@ -19,6 +19,8 @@ package v1
 import (
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/karmada-io/dashboard/pkg/resource/cluster"
 )
 
 // PostClusterRequest is the request body for creating a cluster.
@@ -31,12 +33,25 @@ type PostClusterRequest struct {
 	ClusterProvider         string                   `json:"clusterProvider"`
 	ClusterRegion           string                   `json:"clusterRegion"`
 	ClusterZones            []string                 `json:"clusterZones"`
+	// Preflight runs the cluster preflight checks first and aborts the join when one of them fails.
+	Preflight bool `json:"preflight"`
+	// Async returns the join operation right away instead of waiting for the join to finish, its
+	// progress can be polled with GET /operations/:id.
+	Async bool `json:"async"`
 }
 
 // PostClusterResponse is the response body for creating a cluster.
 type PostClusterResponse struct {
 }
 
+// PreflightClusterRequest is the request body for checking whether a cluster can be joined.
+type PreflightClusterRequest struct {
+	MemberClusterKubeConfig string                   `json:"memberClusterKubeconfig" binding:"required"`
+	SyncMode                v1alpha1.ClusterSyncMode `json:"syncMode" binding:"required"`
+	MemberClusterName       string                   `json:"memberClusterName" binding:"required"`
+	MemberClusterNamespace  string                   `json:"memberClusterNamespace"`
+}
+
 // LabelRequest is the request body for labeling a cluster.
 type LabelRequest struct {
 	Key   string `json:"key"`
@@ -59,11 +74,90 @@ type PutClusterRequest struct {
 // PutClusterResponse is the response body for updating a cluster.
 type PutClusterResponse struct{}
 
+// BulkUpdateClustersRequest is the request body for changing labels and taints of many clusters.
+type BulkUpdateClustersRequest struct {
+	// LabelSelector selects the clusters to update.
+	LabelSelector string `json:"labelSelector"`
+	// AllClusters must be set to update every cluster with an empty LabelSelector.
+	AllClusters bool                     `json:"allClusters"`
+	Labels      []cluster.LabelOperation `json:"labels" binding:"dive"`
+	Taints      []cluster.TaintOperation `json:"taints" binding:"dive"`
+	// DryRun only validates the changes on the server and returns the resulting labels and taints.
+	DryRun bool `json:"dryRun"`
+}
+
+// RotateClusterCredentialsRequest is the request body for replacing the credentials of a push mode cluster.
+type RotateClusterCredentialsRequest struct {
+	// MemberClusterKubeConfig must grant cluster-admin in the member cluster, it is used to issue
+	// the credentials karmada keeps and is not stored itself.
+	MemberClusterKubeConfig string `json:"memberClusterKubeconfig" binding:"required"`
+	// RegenerateToken issues new service account tokens, e.g. after the signing key of the member
+	// cluster rotated.
+	RegenerateToken bool `json:"regenerateToken"`
+	// TimeoutSeconds bounds the wait for the cluster to become Ready, 2 minutes by default. A cluster that
+	// was Ready before the rotation must stay Ready for another 45 seconds on top of it.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// WarnDays is how many days ahead of expiry credentials are reported as expiring, 30 by default.
+	WarnDays int `json:"warnDays"`
+	// Async returns the rotation operation right away, see PostClusterRequest.Async.
+	Async bool `json:"async"`
+}
+
+// ClusterCredentialsRequest defines the query structure for reading the credential expiries of a cluster.
+type ClusterCredentialsRequest struct {
+	// WarnDays is how many days ahead of expiry credentials are reported as expiring, 30 by default.
+	WarnDays int `form:"warnDays"`
+}
+
 // DeleteClusterRequest is the request body for deleting a cluster.
 type DeleteClusterRequest struct {
 	MemberClusterName string `uri:"name" binding:"required"`
 }
 
+// UnjoinClusterRequest is the request body for removing a cluster from karmada.
+type UnjoinClusterRequest struct {
+	// MemberClusterKubeConfig is optional, when set the objects the join created in the member
+	// cluster are removed as well.
+	MemberClusterKubeConfig string `json:"memberClusterKubeconfig"`
+	// MemberClusterNamespace is the namespace of the karmada-agent of a pull mode cluster,
+	// karmada-system by default.
+	MemberClusterNamespace string `json:"memberClusterNamespace"`
+	// Drain evicts the workloads from the cluster before deleting it.
+	Drain bool `json:"drain"`
+	// Force removes the finalizers that block the deletion and ignores cleanup errors, for clusters
+	// that are unreachable.
+	Force bool `json:"force"`
+	// TimeoutSeconds bounds each wait of the unjoin, 60 seconds by default.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// Async returns the unjoin operation right away, see PostClusterRequest.Async.
+	Async bool `json:"async"`
+}
+
+// DrainClusterRequest is the request body for draining a cluster.
+type DrainClusterRequest struct {
+	// TimeoutSeconds bounds the wait for the workloads to be evicted, 10 minutes by default.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// Async returns the drain operation right away, see PostClusterRequest.Async.
+	Async bool `json:"async"`
+}
+
+// FailoverDrillRequest is the request body for running a failover drill against a cluster.
+type FailoverDrillRequest struct {
+	// Namespaces limits the drill to the workloads of these namespaces.
+	Namespaces []string `json:"namespaces" binding:"required,min=1"`
+	// TimeoutSeconds bounds the wait for the workloads to recover, 10 minutes by default.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// Async returns the drill operation right away, see PostClusterRequest.Async.
+	Async bool `json:"async"`
+}
+
+// ClusterKindSupportRequest defines the query structure for checking whether a cluster serves a kind.
+type ClusterKindSupportRequest struct {
+	// APIVersion narrows the lookup to a group version, any group version matches when empty.
+	APIVersion string `form:"apiVersion"`
+	Kind       string `form:"kind" binding:"required"`
+}
+
 // DeleteClusterResponse is the response body for deleting a cluster.
 type DeleteClusterResponse struct {
 }
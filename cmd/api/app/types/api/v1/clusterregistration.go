@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// CreateBootstrapTokenRequest is the request body for creating a cluster registration token.
+type CreateBootstrapTokenRequest struct {
+	// TTLSeconds is how long the token is valid, 24 hours by default.
+	TTLSeconds  int64  `json:"ttlSeconds"`
+	Description string `json:"description"`
+	// APIServerEndpoint is the karmada-apiserver address reachable from the member clusters, the
+	// server of the dashboard's karmada kubeconfig by default.
+	APIServerEndpoint string `json:"apiServerEndpoint"`
+}
+
+// CertificateSigningRequestApprovalRequest is the request body for approving or denying a CSR.
+type CertificateSigningRequestApprovalRequest struct {
+	Message string `json:"message"`
+}
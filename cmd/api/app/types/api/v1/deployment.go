@@ -25,3 +25,8 @@ type CreateDeploymentRequest struct {
 
 // CreateDeploymentResponse defines the response structure for creating a deployment.
 type CreateDeploymentResponse struct{}
+
+// ScaleDeploymentRequest defines the request structure for scaling a deployment.
+type ScaleDeploymentRequest struct {
+	Replicas *int32 `json:"replicas" binding:"required,min=0"`
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// PostFederatedHPARequest is the request body for creating a FederatedHPA or a CronFederatedHPA
+// from its YAML content.
+type PostFederatedHPARequest struct {
+	Namespace string `json:"namespace" binding:"required"`
+	Content   string `json:"content" binding:"required"`
+}
+
+// PutFederatedHPARequest is the request body for updating a FederatedHPA or a CronFederatedHPA,
+// only the spec of the content is applied.
+type PutFederatedHPARequest struct {
+	Content string `json:"content" binding:"required"`
+}
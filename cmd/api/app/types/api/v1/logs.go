@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// WorkloadLogsRequest defines the query structure for streaming the logs of a workload across member clusters.
+type WorkloadLogsRequest struct {
+	SinceSeconds *int64 `form:"sinceSeconds" binding:"omitempty,min=1"`
+	TailLines    *int64 `form:"tailLines" binding:"omitempty,min=0"`
+	// Container streams only the containers with this name, every container when empty.
+	Container string `form:"container"`
+	// Filter is a regular expression the content of the streamed lines must match.
+	Filter string `form:"filter"`
+	// Previous streams the logs of the previous instance of the containers, it cannot be followed.
+	Previous bool `form:"previous"`
+	// Follow keeps the stream open for new lines, true by default.
+	Follow *bool `form:"follow"`
+}
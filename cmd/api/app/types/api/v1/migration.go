@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// MigrateWorkloadRequest defines the request structure for migrating a workload from one member
+// cluster to another. The migration always runs in the background and is polled as an operation.
+type MigrateWorkloadRequest struct {
+	SourceCluster string `json:"sourceCluster" binding:"required"`
+	TargetCluster string `json:"targetCluster" binding:"required"`
+	// Surge is added to the replicas of a Deployment while it runs on both clusters.
+	Surge int32 `json:"surge" binding:"min=0"`
+	// TimeoutSeconds bounds the wait for the target cluster to become ready, 10 minutes by default.
+	// The migration is rolled back when it passes.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
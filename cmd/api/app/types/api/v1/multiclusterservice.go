@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+)
+
+// MultiClusterServiceFromServiceRequest is the request body for exposing a Service of the control
+// plane as a MultiClusterService of the same name.
+type MultiClusterServiceFromServiceRequest struct {
+	// Types defaults to CrossCluster.
+	Types []networkingv1alpha1.ExposureType `json:"types"`
+	// ProviderClusters and ConsumerClusters default to all clusters.
+	ProviderClusters []string `json:"providerClusters"`
+	ConsumerClusters []string `json:"consumerClusters"`
+	// DryRun returns the MultiClusterService without creating it.
+	DryRun bool `json:"dryRun"`
+}
+
+// PostMultiClusterServiceRequest is the request body for creating a MultiClusterService or a
+// MultiClusterIngress from its YAML content.
+type PostMultiClusterServiceRequest struct {
+	Namespace string `json:"namespace" binding:"required"`
+	Content   string `json:"content" binding:"required"`
+}
+
+// PutMultiClusterServiceRequest is the request body for updating a MultiClusterService or a
+// MultiClusterIngress, only the spec of the content is applied.
+type PutMultiClusterServiceRequest struct {
+	Content string `json:"content" binding:"required"`
+}
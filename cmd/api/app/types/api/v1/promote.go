@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "time"
+
+// PromoteResource identifies a member cluster object to promote, e.g. kind Deployment.
+type PromoteResource struct {
+	Kind      string `json:"kind" binding:"required"`
+	Namespace string `json:"namespace" binding:"required"`
+	Name      string `json:"name" binding:"required"`
+}
+
+// PromoteRequest defines the request structure for planning and running the promotion of member
+// cluster objects into the Karmada control plane.
+type PromoteRequest struct {
+	Resources []PromoteResource `json:"resources" binding:"required,min=1,dive"`
+	// IncludeDependencies adds the ConfigMaps, Secrets and Services used by the selected workloads.
+	IncludeDependencies bool `json:"includeDependencies"`
+}
+
+// PromoteVerifyRequest defines the query of the verification of a promoted object, Since is the
+// time of the promotion.
+type PromoteVerifyRequest struct {
+	Since time.Time `form:"since" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+}
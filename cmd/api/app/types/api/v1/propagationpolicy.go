@@ -50,3 +50,15 @@ type DeletePropagationPolicyRequest struct {
 // DeletePropagationPolicyResponse defines the response structure for deleting a propagation policy.
 type DeletePropagationPolicyResponse struct {
 }
+
+// DiffPropagationPolicyRevisionsRequest defines the query structure for diffing two revisions of a propagation policy.
+type DiffPropagationPolicyRevisionsRequest struct {
+	From int64 `form:"from" binding:"required"`
+	To   int64 `form:"to" binding:"required"`
+}
+
+// RollbackPropagationPolicyRequest defines the query structure for rolling back a propagation policy.
+type RollbackPropagationPolicyRequest struct {
+	// DryRun only returns the preview of bindings that would move, without changing the policy.
+	DryRun bool `form:"dryRun"`
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "github.com/karmada-io/dashboard/pkg/resource/template"
+
+// InlinePropagationPolicy defines the PropagationPolicy created together with a resource template.
+// Either Content holds a complete policy in YAML, or the policy is generated from ClusterNames.
+type InlinePropagationPolicy struct {
+	Name         string   `json:"name"`
+	ClusterNames []string `json:"clusterNames"`
+	Content      string   `json:"content"`
+}
+
+// CreateResourceTemplateRequest defines the request structure for creating a resource template,
+// e.g. a StatefulSet or a ConfigMap. The resource is described either by its YAML Content or by
+// the structured fields, e.g. image and replicas, which are inlined into the request.
+type CreateResourceTemplateRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Content   string `json:"content"`
+	*template.Fields
+	PropagationPolicy *InlinePropagationPolicy `json:"propagationPolicy"`
+}
+
+// UpdateResourceTemplateRequest defines the request structure for updating a resource template,
+// either by its YAML Content or by the structured fields to change.
+type UpdateResourceTemplateRequest struct {
+	Content string `json:"content"`
+	*template.Fields
+	PropagationPolicy *InlinePropagationPolicy `json:"propagationPolicy"`
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+
+	"github.com/karmada-io/dashboard/pkg/resource/scheduling"
+)
+
+// SimulateSchedulingRequest defines the request structure for simulating a placement change.
+type SimulateSchedulingRequest struct {
+	// Namespace and Binding optionally name the ResourceBinding the simulation is based on.
+	Namespace string `json:"namespace"`
+	Binding   string `json:"binding"`
+	// Resource is required when Binding is empty.
+	Resource            workv1alpha2.ObjectReference      `json:"resource"`
+	Placement           policyv1alpha1.Placement          `json:"placement" binding:"required"`
+	Replicas            int32                             `json:"replicas"`
+	ReplicaRequirements *workv1alpha2.ReplicaRequirements `json:"replicaRequirements"`
+	// ClusterOverrides change cluster labels, taints and capacity in the simulated snapshot only.
+	ClusterOverrides []scheduling.ClusterOverride `json:"clusterOverrides"`
+}
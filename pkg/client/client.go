@@ -26,6 +26,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
+	mcsclientset "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
 )
 
 // LoadRestConfig creates a rest.Config using the passed kubeconfig. If context is empty, current context in kubeconfig will be used.
@@ -126,6 +127,19 @@ func karmadaClientFromRequest(request *http.Request) (karmadaclientset.Interface
 	return karmadaclientset.NewForConfig(config)
 }
 
+// GetMCSClientFromRequest creates a clientset of the multi-cluster services API
+// (ServiceExport/ServiceImport) of the Karmada APIServer from an HTTP request.
+func GetMCSClientFromRequest(request *http.Request) (mcsclientset.Interface, error) {
+	if !isKarmadaInitialized() {
+		return nil, fmt.Errorf("client package not initialized")
+	}
+	config, err := restConfigFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return mcsclientset.NewForConfig(config)
+}
+
 // GetKarmadaClientFromRequestForKarmadaAPIServer creates a Kubernetes clientset from an HTTP request
 // for the Karmada APIServer, based on `Authorization` header
 func GetKarmadaClientFromRequestForKarmadaAPIServer(request *http.Request) (kubeclient.Interface, error) {
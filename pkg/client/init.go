@@ -21,10 +21,16 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
 )
@@ -319,6 +325,50 @@ func InClusterClientForMemberCluster(clusterName string) kubeclient.Interface {
 	return inClusterClientForMemberAPIServer
 }
 
+// ClientForMemberClusterWithTimeout returns a kubernetes client for member apiserver whose requests
+// time out after the given duration, for callers that must not wait on an unreachable cluster.
+func ClientForMemberClusterWithTimeout(clusterName string, timeout time.Duration) (kubeclient.Interface, error) {
+	restConfig, _, err := GetKarmadaConfig()
+	if err != nil {
+		return nil, err
+	}
+	memberConfig, err := GetMemberConfig()
+	if err != nil {
+		return nil, err
+	}
+	memberConfig = rest.CopyConfig(memberConfig)
+	memberConfig.Host = restConfig.Host + fmt.Sprintf(proxyURL, clusterName)
+	memberConfig.Timeout = timeout
+	return kubeclient.NewForConfig(memberConfig)
+}
+
+// DynamicClientForMemberClusterWithTimeout returns a dynamic client for member apiserver along with a
+// REST mapper resolving the resources of its kinds, for callers working with arbitrary kinds. Requests
+// of both, discovery included, time out after the given duration.
+func DynamicClientForMemberClusterWithTimeout(clusterName string, timeout time.Duration) (dynamic.Interface, meta.RESTMapper, error) {
+	restConfig, _, err := GetKarmadaConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	memberConfig, err := GetMemberConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	memberConfig = rest.CopyConfig(memberConfig)
+	memberConfig.Host = restConfig.Host + fmt.Sprintf(proxyURL, clusterName)
+	memberConfig.Timeout = timeout
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(memberConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(memberConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	return dynamicClient, mapper, nil
+}
+
 // ConvertRestConfigToAPIConfig converts a rest.Config to a clientcmdapi.Config.
 func ConvertRestConfigToAPIConfig(restConfig *rest.Config) *clientcmdapi.Config {
 	// 将 rest.Config 转换为 clientcmdapi.Config
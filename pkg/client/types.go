@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -50,4 +51,5 @@ type ResourceVerber interface {
 	Get(kind string, namespace string, name string) (runtime.Object, error)
 	Delete(kind string, namespace string, name string, deleteNow bool) error
 	Create(object *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Table(kind string, namespace string) (*metav1.Table, error)
 }
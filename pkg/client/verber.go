@@ -18,9 +18,11 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/gobuffalo/flect"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,12 +33,36 @@ import (
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 )
 
+// tableAcceptHeader asks the apiserver to convert lists to tables, the way kubectl get prints them.
+const tableAcceptHeader = "application/json;as=Table;v=v1;g=meta.k8s.io,application/json"
+
+// gvrCache maps kinds to the resources discovered on a single apiserver.
+type gvrCache struct {
+	sync.RWMutex
+	kinds map[string]schema.GroupVersionResource
+}
+
+func newGVRCache() *gvrCache {
+	return &gvrCache{kinds: map[string]schema.GroupVersionResource{}}
+}
+
+func (c *gvrCache) get(kind string) (schema.GroupVersionResource, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	gvr, exists := c.kinds[kind]
+	return gvr, exists
+}
+
 var (
-	kindToGroupVersionResource = map[string]schema.GroupVersionResource{}
+	kindToGroupVersionResource = newGVRCache()
+	// memberKindToGroupVersionResource holds a *gvrCache per member cluster, the member clusters
+	// do not necessarily serve the same resources as the control plane.
+	memberKindToGroupVersionResource sync.Map
 )
 
 // resourceVerber is a struct responsible for doing common verb operations on resources, like
@@ -44,6 +70,7 @@ var (
 type resourceVerber struct {
 	client    dynamic.Interface
 	discovery discovery.DiscoveryInterface
+	cache     *gvrCache
 }
 
 func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructured.Unstructured) schema.GroupVersionResource {
@@ -57,7 +84,7 @@ func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructur
 }
 
 func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.GroupVersionResource, error) {
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := v.cache.get(kind); exists {
 		klog.V(3).InfoS("GroupVersionResource cache hit", "kind", kind)
 		return gvr, nil
 	}
@@ -73,7 +100,7 @@ func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.Group
 		return schema.GroupVersionResource{}, err
 	}
 
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := v.cache.get(kind); exists {
 		return gvr, nil
 	}
 
@@ -81,6 +108,8 @@ func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.Group
 }
 
 func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.APIResourceList) error {
+	v.cache.Lock()
+	defer v.cache.Unlock()
 	for _, resource := range resourceList {
 		gv, err := schema.ParseGroupVersion(resource.GroupVersion)
 		if err != nil {
@@ -101,10 +130,10 @@ func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.A
 			}
 
 			// Mapping for core resources
-			kindToGroupVersionResource[strings.ToLower(apiResource.Kind)] = gvr
+			v.cache.kinds[strings.ToLower(apiResource.Kind)] = gvr
 
 			// Mapping for CRD resources with custom kind
-			kindToGroupVersionResource[crdKind] = gvr
+			v.cache.kinds[crdKind] = gvr
 		}
 	}
 
@@ -185,13 +214,61 @@ func (v *resourceVerber) Create(object *unstructured.Unstructured) (*unstructure
 	return v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{})
 }
 
+// Table lists the resources of the given kind in the given namespace, or in all namespaces when the
+// namespace is empty, converted to a table by the apiserver.
+func (v *resourceVerber) Table(kind string, namespace string) (*metav1.Table, error) {
+	gvr, err := v.groupVersionResourceFromKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	segments := []string{"/apis", gvr.Group, gvr.Version}
+	if gvr.Group == "" {
+		segments = []string{"/api", gvr.Version}
+	}
+	if namespace != "" {
+		segments = append(segments, "namespaces", namespace)
+	}
+	segments = append(segments, gvr.Resource)
+
+	raw, err := v.discovery.RESTClient().Get().
+		AbsPath(segments...).
+		SetHeader("Accept", tableAcceptHeader).
+		DoRaw(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	table := &metav1.Table{}
+	if err = json.Unmarshal(raw, table); err != nil {
+		return nil, err
+	}
+	if table.Kind != "Table" {
+		return nil, fmt.Errorf("the apiserver did not convert %s to a table", gvr.Resource)
+	}
+	return table, nil
+}
+
 // VerberClient returns a resourceVerber client.
 func VerberClient(request *http.Request) (ResourceVerber, error) {
 	restConfig, err := restConfigFromRequest(request)
 	if err != nil {
 		return nil, err
 	}
+	return newResourceVerber(restConfig, kindToGroupVersionResource)
+}
+
+// MemberVerberClient returns a resourceVerber client for a member cluster, reached through the
+// cluster proxy of the karmada-apiserver.
+func MemberVerberClient(request *http.Request, clusterName string) (ResourceVerber, error) {
+	restConfig, err := restConfigFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	restConfig.Host = restConfig.Host + fmt.Sprintf(proxyURL, clusterName)
+	cache, _ := memberKindToGroupVersionResource.LoadOrStore(clusterName, newGVRCache())
+	return newResourceVerber(restConfig, cache.(*gvrCache))
+}
 
+func newResourceVerber(restConfig *rest.Config, cache *gvrCache) (ResourceVerber, error) {
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
 	if err != nil {
 		return nil, err
@@ -207,5 +284,6 @@ func VerberClient(request *http.Request) (ResourceVerber, error) {
 	return &resourceVerber{
 		client:    dynamicClient,
 		discovery: discoveryClient,
+		cache:     cache,
 	}, nil
 }
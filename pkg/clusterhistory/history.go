@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterhistory records the condition transitions of member clusters so that the dashboard
+// can show how often and how long a cluster was not ready.
+package clusterhistory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+)
+
+const (
+	historyKey = "transitions"
+
+	// maxTransitions bounds the number of transitions kept per cluster.
+	maxTransitions = 1000
+	// retention is how long transitions are kept, the longest availability window.
+	retention = 30 * 24 * time.Hour
+
+	// ManagedByLabel marks ConfigMaps that hold cluster condition history.
+	ManagedByLabel = "dashboard.karmada.io/cluster-history"
+	// ClusterNameAnnotation records the cluster the history belongs to.
+	ClusterNameAnnotation = "dashboard.karmada.io/cluster-name"
+)
+
+// Transition is a change of the status of a cluster condition.
+type Transition struct {
+	Type      string                 `json:"type"`
+	Status    metav1.ConditionStatus `json:"status"`
+	Reason    string                 `json:"reason,omitempty"`
+	Message   string                 `json:"message,omitempty"`
+	Timestamp metav1.Time            `json:"timestamp"`
+}
+
+// Availability is the share of a time window a cluster was Ready.
+type Availability struct {
+	Window string `json:"window"`
+	// Percentage is empty when nothing is known about the window.
+	Percentage *float64 `json:"percentage,omitempty"`
+	// ObservedSeconds is how much of the window the history covers, the percentage only accounts
+	// for this part.
+	ObservedSeconds int64 `json:"observedSeconds"`
+}
+
+// History is the condition history of a single cluster.
+type History struct {
+	Cluster string `json:"cluster"`
+	// Transitions are newest first.
+	Transitions  []Transition   `json:"transitions"`
+	Availability []Availability `json:"availability"`
+}
+
+// availabilityWindows are the windows the availability is reported for.
+var availabilityWindows = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"24h", 24 * time.Hour},
+	{"7d", 7 * 24 * time.Hour},
+	{"30d", 30 * 24 * time.Hour},
+}
+
+var store = configmapstore.New("cluster history", "karmada-dashboard-cluster-history-", ManagedByLabel)
+
+func decodeTransitions(name string, data map[string]string) []Transition {
+	transitions := make([]Transition, 0)
+	raw, ok := data[historyKey]
+	if !ok {
+		return transitions
+	}
+	if err := json.Unmarshal([]byte(raw), &transitions); err != nil {
+		klog.Warningf("Skipping malformed cluster history in ConfigMap %s: %v", name, err)
+		return make([]Transition, 0)
+	}
+	return transitions
+}
+
+// GetHistory returns the recorded transitions of the cluster and its availability.
+func GetHistory(ctx context.Context, cluster string) (*History, error) {
+	configMap, err := store.Get(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	transitions := make([]Transition, 0)
+	if configMap != nil {
+		transitions = decodeTransitions(configMap.Name, configMap.Data)
+	}
+
+	now := time.Now()
+	ready := make([]Transition, 0, len(transitions))
+	for _, t := range transitions {
+		if t.Type == clusterv1alpha1.ClusterConditionReady {
+			ready = append(ready, t)
+		}
+	}
+	result := &History{
+		Cluster:      cluster,
+		Transitions:  make([]Transition, 0, len(transitions)),
+		Availability: make([]Availability, 0, len(availabilityWindows)),
+	}
+	for _, window := range availabilityWindows {
+		result.Availability = append(result.Availability, computeAvailability(ready, window.name, window.duration, now))
+	}
+	// newest first
+	for i := len(transitions) - 1; i >= 0; i-- {
+		result.Transitions = append(result.Transitions, transitions[i])
+	}
+	return result, nil
+}
+
+// recordTransitions appends the transitions to the history of the cluster, skipping the ones that
+// are already recorded, e.g. by another dashboard replica.
+func recordTransitions(ctx context.Context, cluster string, newTransitions []Transition) error {
+	if len(newTransitions) == 0 {
+		return nil
+	}
+	annotations := map[string]string{ClusterNameAnnotation: cluster}
+	return store.Modify(ctx, cluster, nil, annotations, func(data map[string]string) (bool, error) {
+		transitions, changed := mergeTransitions(decodeTransitions(store.Name(cluster), data), newTransitions, time.Now())
+		if !changed {
+			return false, nil
+		}
+		buff, err := json.Marshal(transitions)
+		if err != nil {
+			return false, err
+		}
+		data[historyKey] = string(buff)
+		return true, nil
+	})
+}
+
+// deleteHistory removes the history of a cluster that left karmada.
+func deleteHistory(ctx context.Context, cluster string) error {
+	return store.Delete(ctx, cluster)
+}
+
+// mergeTransitions adds the new transitions to the recorded ones, oldest first. Transitions older
+// than the retention are dropped, except the latest one of each condition type before the
+// retention, which tells the state at the beginning of the longest window. It reports whether any
+// of the added transitions was not recorded yet.
+func mergeTransitions(recorded, added []Transition, now time.Time) ([]Transition, bool) {
+	type key struct {
+		conditionType string
+		status        metav1.ConditionStatus
+		timestamp     int64
+	}
+	seen := make(map[key]bool, len(recorded))
+	merged := make([]Transition, 0, len(recorded)+len(added))
+	for _, t := range recorded {
+		k := key{t.Type, t.Status, t.Timestamp.Unix()}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		merged = append(merged, t)
+	}
+	changed := false
+	for _, t := range added {
+		k := key{t.Type, t.Status, t.Timestamp.Unix()}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		merged = append(merged, t)
+		changed = true
+	}
+	if !changed {
+		return merged, false
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Timestamp.Before(&merged[j].Timestamp)
+	})
+
+	cutoff := now.Add(-retention)
+	latestBeforeCutoff := map[string]int{}
+	for i, t := range merged {
+		if t.Timestamp.Time.Before(cutoff) {
+			latestBeforeCutoff[t.Type] = i
+		}
+	}
+	pruned := make([]Transition, 0, len(merged))
+	for i, t := range merged {
+		if t.Timestamp.Time.Before(cutoff) && latestBeforeCutoff[t.Type] != i {
+			continue
+		}
+		pruned = append(pruned, t)
+	}
+	if len(pruned) > maxTransitions {
+		pruned = pruned[len(pruned)-maxTransitions:]
+	}
+	return pruned, true
+}
+
+// computeAvailability returns the share of the window the cluster was Ready, from its Ready
+// transitions sorted oldest first. The time before the first transition is unknown and not counted.
+func computeAvailability(ready []Transition, name string, window time.Duration, now time.Time) Availability {
+	result := Availability{Window: name}
+	start := now.Add(-window)
+	var observed, up time.Duration
+	for i, t := range ready {
+		from := t.Timestamp.Time
+		to := now
+		if i+1 < len(ready) {
+			to = ready[i+1].Timestamp.Time
+		}
+		if from.Before(start) {
+			from = start
+		}
+		if !to.After(from) {
+			continue
+		}
+		observed += to.Sub(from)
+		if t.Status == metav1.ConditionTrue {
+			up += to.Sub(from)
+		}
+	}
+	result.ObservedSeconds = int64(observed.Seconds())
+	if observed > 0 {
+		percentage := float64(up) / float64(observed) * 100
+		result.Percentage = &percentage
+	}
+	return result
+}
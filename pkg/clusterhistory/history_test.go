@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterhistory
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func transition(status metav1.ConditionStatus, at time.Time) Transition {
+	return Transition{Type: "Ready", Status: status, Timestamp: metav1.NewTime(at)}
+}
+
+func TestComputeAvailability(t *testing.T) {
+	now := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	ready := []Transition{
+		transition(metav1.ConditionTrue, now.Add(-48*time.Hour)),
+		transition(metav1.ConditionFalse, now.Add(-12*time.Hour)),
+		transition(metav1.ConditionTrue, now.Add(-6*time.Hour)),
+	}
+
+	day := computeAvailability(ready, "24h", 24*time.Hour, now)
+	if day.ObservedSeconds != int64((24 * time.Hour).Seconds()) {
+		t.Fatalf("expected the whole day to be observed, got %ds", day.ObservedSeconds)
+	}
+	if day.Percentage == nil || *day.Percentage != 75 {
+		t.Fatalf("expected 75%% availability over 24h, got %v", day.Percentage)
+	}
+
+	// only the last 48 hours are known, the rest of the week is not counted
+	week := computeAvailability(ready, "7d", 7*24*time.Hour, now)
+	if week.ObservedSeconds != int64((48 * time.Hour).Seconds()) {
+		t.Fatalf("expected 48h to be observed, got %ds", week.ObservedSeconds)
+	}
+	if week.Percentage == nil || *week.Percentage != 87.5 {
+		t.Fatalf("expected 87.5%% availability over 7d, got %v", week.Percentage)
+	}
+
+	if empty := computeAvailability(nil, "24h", 24*time.Hour, now); empty.Percentage != nil || empty.ObservedSeconds != 0 {
+		t.Fatalf("expected unknown availability without history, got %+v", empty)
+	}
+}
+
+func TestMergeTransitions(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	recorded := []Transition{
+		transition(metav1.ConditionTrue, now.Add(-40*24*time.Hour)),
+		transition(metav1.ConditionFalse, now.Add(-35*24*time.Hour)),
+		transition(metav1.ConditionTrue, now.Add(-time.Hour)),
+	}
+
+	if _, changed := mergeTransitions(recorded, recorded[2:], now); changed {
+		t.Fatalf("expected already recorded transitions to be skipped")
+	}
+
+	merged, changed := mergeTransitions(recorded, []Transition{transition(metav1.ConditionFalse, now.Add(-time.Minute))}, now)
+	if !changed {
+		t.Fatalf("expected a new transition to be recorded")
+	}
+	// the oldest transition is pruned, the latest one before the retention is kept
+	expected := []metav1.ConditionStatus{metav1.ConditionFalse, metav1.ConditionTrue, metav1.ConditionFalse}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %d transitions, got %+v", len(expected), merged)
+	}
+	for i, status := range expected {
+		if merged[i].Status != status {
+			t.Fatalf("unexpected transition %d: %+v", i, merged[i])
+		}
+	}
+	if !merged[0].Timestamp.Time.Equal(now.Add(-35 * 24 * time.Hour)) {
+		t.Fatalf("expected the latest transition before the retention to be kept, got %v", merged[0].Timestamp)
+	}
+}
+
+func TestConditionTransitions(t *testing.T) {
+	at := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	oldConditions := []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: at}}
+	newConditions := []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: at},
+		{Type: "CompleteAPIEnablements", Status: metav1.ConditionFalse, Reason: "Partial", LastTransitionTime: at},
+	}
+	transitions := conditionTransitions(oldConditions, newConditions)
+	if len(transitions) != 1 || transitions[0].Type != "CompleteAPIEnablements" || transitions[0].Reason != "Partial" {
+		t.Fatalf("unexpected transitions: %+v", transitions)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterhistory
+
+import (
+	"context"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/informer"
+)
+
+// writeTimeout bounds a single history write issued from an informer event.
+const writeTimeout = 30 * time.Second
+
+// Init sets up the history store in the given namespace and starts recording the condition
+// transitions of clusters. It must be called after informer.Init.
+func Init(k8sClient kubernetes.Interface, namespace string) {
+	store.Init(k8sClient, namespace)
+
+	_, err := informer.ClusterInformer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			cluster, ok := obj.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return
+			}
+			// catch up with the transitions that happened while the dashboard was not running,
+			// the ones already recorded are skipped
+			record(cluster.Name, conditionTransitions(nil, cluster.Status.Conditions))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCluster, ok := oldObj.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return
+			}
+			newCluster, ok := newObj.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return
+			}
+			record(newCluster.Name, conditionTransitions(oldCluster.Status.Conditions, newCluster.Status.Conditions))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			cluster, ok := obj.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+			defer cancel()
+			if err := deleteHistory(ctx, cluster.Name); err != nil {
+				klog.ErrorS(err, "Failed to delete cluster history", "cluster", cluster.Name)
+			}
+		},
+	})
+	if err != nil {
+		klog.Warningf("Failed to add Cluster event handler, cluster history is not recorded: %v", err)
+		return
+	}
+	klog.InfoS("Cluster history recorder initialized", "namespace", namespace)
+}
+
+func record(cluster string, transitions []Transition) {
+	if len(transitions) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if err := recordTransitions(ctx, cluster, transitions); err != nil {
+		klog.ErrorS(err, "Failed to record cluster history", "cluster", cluster)
+	}
+}
+
+// conditionTransitions returns the conditions whose status differs from the old conditions.
+func conditionTransitions(oldConditions, newConditions []metav1.Condition) []Transition {
+	previous := make(map[string]metav1.ConditionStatus, len(oldConditions))
+	for _, c := range oldConditions {
+		previous[c.Type] = c.Status
+	}
+	transitions := make([]Transition, 0)
+	for _, c := range newConditions {
+		if status, ok := previous[c.Type]; ok && status == c.Status {
+			continue
+		}
+		timestamp := c.LastTransitionTime
+		if timestamp.IsZero() {
+			timestamp = metav1.Now()
+		}
+		transitions = append(transitions, Transition{
+			Type:      c.Type,
+			Status:    c.Status,
+			Reason:    c.Reason,
+			Message:   c.Message,
+			Timestamp: timestamp,
+		})
+	}
+	return transitions
+}
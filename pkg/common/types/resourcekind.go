@@ -24,41 +24,52 @@ type ResourceKind string
 
 // List of all resource kinds supported by the UI.
 const (
-	ResourceKindCluster                  = "cluster"
-	ResourceKindPropagationPolicy        = "propagationpolicy"
-	ResourceKindClusterPropagationPolicy = "clusterpropagationpolicy"
-	ResourceKindOverridePolicy           = "overridepolicy"
-	ResourceKindClusterOverridePolicy    = "clusteroverridepolicy"
-	ResourceKindConfigMap                = "configmap"
-	ResourceKindDaemonSet                = "daemonset"
-	ResourceKindDeployment               = "deployment"
-	ResourceKindEvent                    = "event"
-	ResourceKindHorizontalPodAutoscaler  = "horizontalpodautoscaler"
-	ResourceKindIngress                  = "ingress"
-	ResourceKindServiceAccount           = "serviceaccount"
-	ResourceKindJob                      = "job"
-	ResourceKindCronJob                  = "cronjob"
-	ResourceKindLimitRange               = "limitrange"
-	ResourceKindNamespace                = "namespace"
-	ResourceKindNode                     = "node"
-	ResourceKindPersistentVolumeClaim    = "persistentvolumeclaim"
-	ResourceKindPersistentVolume         = "persistentvolume"
-	ResourceKindCustomResourceDefinition = "customresourcedefinition"
-	ResourceKindPod                      = "pod"
-	ResourceKindReplicaSet               = "replicaset"
-	ResourceKindReplicationController    = "replicationcontroller"
-	ResourceKindResourceQuota            = "resourcequota"
-	ResourceKindSecret                   = "secret"
-	ResourceKindService                  = "service"
-	ResourceKindStatefulSet              = "statefulset"
-	ResourceKindStorageClass             = "storageclass"
-	ResourceKindClusterRole              = "clusterrole"
-	ResourceKindClusterRoleBinding       = "clusterrolebinding"
-	ResourceKindRole                     = "role"
-	ResourceKindRoleBinding              = "rolebinding"
-	ResourceKindEndpoint                 = "endpoint"
-	ResourceKindNetworkPolicy            = "networkpolicy"
-	ResourceKindIngressClass             = "ingressclass"
+	ResourceKindCluster                   = "cluster"
+	ResourceKindPropagationPolicy         = "propagationpolicy"
+	ResourceKindClusterPropagationPolicy  = "clusterpropagationpolicy"
+	ResourceKindOverridePolicy            = "overridepolicy"
+	ResourceKindClusterOverridePolicy     = "clusteroverridepolicy"
+	ResourceKindConfigMap                 = "configmap"
+	ResourceKindDaemonSet                 = "daemonset"
+	ResourceKindDeployment                = "deployment"
+	ResourceKindEvent                     = "event"
+	ResourceKindHorizontalPodAutoscaler   = "horizontalpodautoscaler"
+	ResourceKindIngress                   = "ingress"
+	ResourceKindServiceAccount            = "serviceaccount"
+	ResourceKindJob                       = "job"
+	ResourceKindCronJob                   = "cronjob"
+	ResourceKindLimitRange                = "limitrange"
+	ResourceKindNamespace                 = "namespace"
+	ResourceKindNode                      = "node"
+	ResourceKindPersistentVolumeClaim     = "persistentvolumeclaim"
+	ResourceKindPersistentVolume          = "persistentvolume"
+	ResourceKindCustomResourceDefinition  = "customresourcedefinition"
+	ResourceKindPod                       = "pod"
+	ResourceKindReplicaSet                = "replicaset"
+	ResourceKindReplicationController     = "replicationcontroller"
+	ResourceKindResourceQuota             = "resourcequota"
+	ResourceKindSecret                    = "secret"
+	ResourceKindService                   = "service"
+	ResourceKindStatefulSet               = "statefulset"
+	ResourceKindStorageClass              = "storageclass"
+	ResourceKindClusterRole               = "clusterrole"
+	ResourceKindClusterRoleBinding        = "clusterrolebinding"
+	ResourceKindRole                      = "role"
+	ResourceKindRoleBinding               = "rolebinding"
+	ResourceKindEndpoint                  = "endpoint"
+	ResourceKindNetworkPolicy             = "networkpolicy"
+	ResourceKindIngressClass              = "ingressclass"
+	ResourceKindResourceBinding           = "resourcebinding"
+	ResourceKindClusterResourceBinding    = "clusterresourcebinding"
+	ResourceKindWork                      = "work"
+	ResourceKindBootstrapToken            = "bootstraptoken"
+	ResourceKindCertificateSigningRequest = "certificatesigningrequest"
+	ResourceKindFederatedHPA              = "federatedhpa"
+	ResourceKindCronFederatedHPA          = "cronfederatedhpa"
+	ResourceKindMultiClusterService       = "multiclusterservice"
+	ResourceKindMultiClusterIngress       = "multiclusteringress"
+	ResourceKindServiceExport             = "serviceexport"
+	ResourceKindServiceImport             = "serviceimport"
 )
 
 // Scalable method return whether ResourceKind is scalable.
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmapstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const revisionKeyPrefix = "revision-"
+
+// Revision is an entry of a RevisionStore.
+type Revision interface {
+	// RevisionNumber is the sequence number of the revision, starting from 1.
+	RevisionNumber() int64
+}
+
+// RevisionStore keeps numbered revisions per object, one ConfigMap key per revision. At most limit
+// revisions are kept, the oldest ones are pruned first.
+type RevisionStore[R Revision] struct {
+	*Store
+	limit int
+}
+
+// NewRevisionStore returns a revision store on top of store.
+func NewRevisionStore[R Revision](store *Store, limit int) *RevisionStore[R] {
+	return &RevisionStore[R]{Store: store, limit: limit}
+}
+
+func revisionKey(revision int64) string {
+	return fmt.Sprintf("%s%d", revisionKeyPrefix, revision)
+}
+
+// List returns the revisions of the object, newest first.
+func (s *RevisionStore[R]) List(ctx context.Context, key string) ([]R, error) {
+	configMap, err := s.Get(ctx, key)
+	if err != nil || configMap == nil {
+		return make([]R, 0), err
+	}
+	return s.decode(configMap.Name, configMap.Data), nil
+}
+
+func (s *RevisionStore[R]) decode(name string, data map[string]string) []R {
+	revisions := make([]R, 0, len(data))
+	for key, raw := range data {
+		if !strings.HasPrefix(key, revisionKeyPrefix) {
+			continue
+		}
+		if _, err := strconv.ParseInt(strings.TrimPrefix(key, revisionKeyPrefix), 10, 64); err != nil {
+			continue
+		}
+		var revision R
+		if err := yaml.Unmarshal([]byte(raw), &revision); err != nil {
+			klog.Warningf("Skipping malformed %s %s in ConfigMap %s: %v", s.description, key, name, err)
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].RevisionNumber() > revisions[j].RevisionNumber()
+	})
+	return revisions
+}
+
+// Record adds the revision newRevision builds for the next sequence number. Nothing is recorded
+// when unchanged reports that the latest revision holds the same content. The returned revision is
+// the latest one after the call.
+func (s *RevisionStore[R]) Record(ctx context.Context, key string, labels, annotations map[string]string,
+	unchanged func(latest R) bool, newRevision func(number int64) R) (R, error) {
+	var recorded R
+	err := s.Modify(ctx, key, labels, annotations, func(data map[string]string) (bool, error) {
+		revisions := s.decode(s.Name(key), data)
+		next := int64(1)
+		if len(revisions) > 0 {
+			if unchanged(revisions[0]) {
+				recorded = revisions[0]
+				return false, nil
+			}
+			next = revisions[0].RevisionNumber() + 1
+		}
+
+		revision := newRevision(next)
+		buff, err := yaml.Marshal(revision)
+		if err != nil {
+			return false, err
+		}
+		data[revisionKey(next)] = string(buff)
+		// revisions are sorted newest first, drop everything beyond the limit
+		for i := s.limit - 1; i < len(revisions); i++ {
+			delete(data, revisionKey(revisions[i].RevisionNumber()))
+		}
+		recorded = revision
+		return true, nil
+	})
+	return recorded, err
+}
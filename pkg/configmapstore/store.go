@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package configmapstore persists the history the dashboard keeps about objects, such as policy
+// revisions, in ConfigMaps of its own namespace. Every object gets one ConfigMap, named after a hash
+// of the object key so that the name is stable and length-bounded.
+package configmapstore
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// Store keeps the ConfigMaps of one kind of history.
+type Store struct {
+	// description names the history in errors and logs, e.g. "policy revision".
+	description    string
+	prefix         string
+	managedByLabel string
+
+	client    kubernetes.Interface
+	namespace string
+}
+
+// New returns a store whose ConfigMap names start with prefix and which are marked with the
+// managedByLabel. The store is unusable until Init is called.
+func New(description, prefix, managedByLabel string) *Store {
+	return &Store{description: description, prefix: prefix, managedByLabel: managedByLabel}
+}
+
+// Init sets the client and namespace used to persist the ConfigMaps.
+func (s *Store) Init(k8sClient kubernetes.Interface, namespace string) {
+	s.client = k8sClient
+	s.namespace = namespace
+	klog.InfoS("ConfigMap store initialized", "store", s.description, "namespace", namespace)
+}
+
+func (s *Store) storeClient() (kubernetes.Interface, error) {
+	if s.client == nil {
+		return nil, fmt.Errorf("%s store not initialized", s.description)
+	}
+	return s.client, nil
+}
+
+// Name returns the name of the ConfigMap of the object with the given key.
+func (s *Store) Name(key string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return fmt.Sprintf("%s%x", s.prefix, h.Sum64())
+}
+
+// Get returns the ConfigMap of the object, nil when nothing is stored for it.
+func (s *Store) Get(ctx context.Context, key string) (*corev1.ConfigMap, error) {
+	k8sClient, err := s.storeClient()
+	if err != nil {
+		return nil, err
+	}
+	configMap, err := k8sClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.Name(key), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return configMap, err
+}
+
+// Modify applies mutate to the data of the ConfigMap of the object and writes it back, retrying on
+// conflicts with other writers. A missing ConfigMap is created with the given labels and
+// annotations. Nothing is written when mutate reports no change.
+func (s *Store) Modify(ctx context.Context, key string, labels, annotations map[string]string,
+	mutate func(data map[string]string) (bool, error)) error {
+	k8sClient, err := s.storeClient()
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMaps := k8sClient.CoreV1().ConfigMaps(s.namespace)
+		configMap, getErr := configMaps.Get(ctx, s.Name(key), metav1.GetOptions{})
+		exists := true
+		if apierrors.IsNotFound(getErr) {
+			exists = false
+			configMap = s.newConfigMap(key, labels, annotations)
+		} else if getErr != nil {
+			return getErr
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+
+		changed, mutateErr := mutate(configMap.Data)
+		if mutateErr != nil || !changed {
+			return mutateErr
+		}
+
+		var writeErr error
+		if exists {
+			_, writeErr = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+		} else {
+			_, writeErr = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(writeErr) {
+				// lost the race against another writer, retry against the stored object
+				writeErr = apierrors.NewConflict(corev1.Resource("configmaps"), configMap.Name, writeErr)
+			}
+		}
+		return writeErr
+	})
+}
+
+// Delete removes the ConfigMap of the object.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	k8sClient, err := s.storeClient()
+	if err != nil {
+		return err
+	}
+	err = k8sClient.CoreV1().ConfigMaps(s.namespace).Delete(ctx, s.Name(key), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *Store) newConfigMap(key string, labels, annotations map[string]string) *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        s.Name(key),
+			Namespace:   s.namespace,
+			Labels:      map[string]string{s.managedByLabel: "true"},
+			Annotations: annotations,
+		},
+		Data: map[string]string{},
+	}
+	for k, v := range labels {
+		configMap.Labels[k] = v
+	}
+	return configMap
+}
+
+// LogFailure logs a failure to keep history about a change that was already made. History is kept
+// on a best-effort basis, so the failure must not fail the request that made the change.
+func LogFailure(err error, msg string, keysAndValues ...interface{}) {
+	if err != nil {
+		klog.ErrorS(err, msg, keysAndValues...)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmapstore
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.TODO()
+	store := New("test history", "test-history-", "test.karmada.io/managed-by")
+	if _, err := store.Get(ctx, "a"); err == nil {
+		t.Fatalf("Get() on an uninitialized store should fail")
+	}
+	store.Init(fake.NewSimpleClientset(), "karmada-dashboard")
+
+	configMap, err := store.Get(ctx, "a")
+	if err != nil || configMap != nil {
+		t.Fatalf("Get() = %v, %v, expected nothing stored", configMap, err)
+	}
+
+	set := func(value string) func(data map[string]string) (bool, error) {
+		return func(data map[string]string) (bool, error) {
+			if data["k"] == value {
+				return false, nil
+			}
+			data["k"] = value
+			return true, nil
+		}
+	}
+	annotations := map[string]string{"test.karmada.io/key": "a"}
+	for _, value := range []string{"1", "2", "2"} {
+		if err = store.Modify(ctx, "a", nil, annotations, set(value)); err != nil {
+			t.Fatalf("Modify() error = %v", err)
+		}
+	}
+	configMap, err = store.Get(ctx, "a")
+	if err != nil || configMap == nil {
+		t.Fatalf("Get() = %v, %v, expected the stored ConfigMap", configMap, err)
+	}
+	if configMap.Name != store.Name("a") || configMap.Data["k"] != "2" {
+		t.Errorf("Get() = %s %v, expected %s with k=2", configMap.Name, configMap.Data, store.Name("a"))
+	}
+	if configMap.Labels["test.karmada.io/managed-by"] == "" || configMap.Annotations["test.karmada.io/key"] != "a" {
+		t.Errorf("Get() labels %v annotations %v, expected the managed-by label and the key annotation", configMap.Labels, configMap.Annotations)
+	}
+
+	if err = store.Delete(ctx, "a"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if configMap, err = store.Get(ctx, "a"); err != nil || configMap != nil {
+		t.Fatalf("Get() after Delete() = %v, %v, expected nothing stored", configMap, err)
+	}
+	if err = store.Delete(ctx, "a"); err != nil {
+		t.Errorf("Delete() of a missing ConfigMap error = %v", err)
+	}
+}
@@ -20,12 +20,15 @@ type PropertyName string
 
 // List of all property names supported by the UI.
 const (
-	NameProperty              = "name"
-	CreationTimestampProperty = "creationTimestamp"
-	NamespaceProperty         = "namespace"
-	StatusProperty            = "status"
-	TypeProperty              = "type"
-	FirstSeenProperty         = "firstSeen"
-	LastSeenProperty          = "lastSeen"
-	ReasonProperty            = "reason"
+	NameProperty               = "name"
+	CreationTimestampProperty  = "creationTimestamp"
+	NamespaceProperty          = "namespace"
+	StatusProperty             = "status"
+	TypeProperty               = "type"
+	FirstSeenProperty          = "firstSeen"
+	LastSeenProperty           = "lastSeen"
+	ReasonProperty             = "reason"
+	ClusterProperty            = "cluster"
+	PolicyProperty             = "policy"
+	ExecutionNamespaceProperty = "executionNamespace"
 )
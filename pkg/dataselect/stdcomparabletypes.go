@@ -54,6 +54,27 @@ func (s StdComparableString) Contains(otherV ComparableValue) bool {
 	return strings.Contains(string(s), string(other))
 }
 
+// StdComparableStringSet is a wrapper for a list of strings that implements ComparableValueInterface.
+// Unlike StdComparableString, filtering matches only when one of the items equals the filter value.
+type StdComparableStringSet []string
+
+// Compare compares two string sets by their joined items.
+func (s StdComparableStringSet) Compare(otherV ComparableValue) int {
+	other := otherV.(StdComparableStringSet)
+	return strings.Compare(strings.Join(s, ","), strings.Join(other, ","))
+}
+
+// Contains checks if other equals one of the items in self.
+func (s StdComparableStringSet) Contains(otherV ComparableValue) bool {
+	other := otherV.(StdComparableString)
+	for _, item := range s {
+		if item == string(other) {
+			return true
+		}
+	}
+	return false
+}
+
 // StdComparableRFC3339Timestamp takes RFC3339 Timestamp strings and compares them as TIMES. In case of time parsing error compares values as strings.
 type StdComparableRFC3339Timestamp string
 
@@ -139,6 +139,36 @@ func TestStdComparableStringContains(t *testing.T) {
 	}
 }
 
+func TestStdComparableStringSetContains(t *testing.T) {
+	cases := []struct {
+		a        StdComparableStringSet
+		b        StdComparableString
+		expected bool
+	}{
+		{
+			StdComparableStringSet{"member1", "member2"},
+			StdComparableString("member2"),
+			true,
+		},
+		{
+			StdComparableStringSet{"member10"},
+			StdComparableString("member1"),
+			false,
+		},
+		{
+			StdComparableStringSet{},
+			StdComparableString("member1"),
+			false,
+		},
+	}
+	for _, c := range cases {
+		actual := c.a.Contains(c.b)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Contains(%+v) == %+v, expected %+v", c.b, actual, c.expected)
+		}
+	}
+}
+
 func TestStdComparableRFC3339Timestamp(t *testing.T) {
 	cases := []struct {
 		a, b     StdComparableRFC3339Timestamp
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native"
+	"github.com/karmada-io/karmada/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DiffType tells how a field of the member object differs from the rendered manifest.
+type DiffType string
+
+// DiffType constants define the kinds of field differences.
+const (
+	// DiffChanged is a field whose live value differs from the rendered one.
+	DiffChanged DiffType = "Changed"
+	// DiffMissing is a field of the rendered manifest the live object lacks.
+	DiffMissing DiffType = "Missing"
+)
+
+// FieldDiff is a single field of the member object that drifted from the rendered manifest.
+type FieldDiff struct {
+	// Path is the field path, e.g. spec.template.spec.containers[name=app].image.
+	Path    string      `json:"path"`
+	Type    DiffType    `json:"type"`
+	Desired interface{} `json:"desired"`
+	Live    interface{} `json:"live,omitempty"`
+}
+
+// ignoredPaths are populated by the member apiserver and never rendered by Karmada.
+var ignoredPaths = map[string]bool{
+	"status":                              true,
+	"metadata.uid":                        true,
+	"metadata.resourceVersion":            true,
+	"metadata.generation":                 true,
+	"metadata.creationTimestamp":          true,
+	"metadata.deletionTimestamp":          true,
+	"metadata.deletionGracePeriodSeconds": true,
+	"metadata.managedFields":              true,
+	"metadata.selfLink":                   true,
+	"metadata.ownerReferences":            true,
+	"metadata.finalizers":                 true,
+}
+
+var interpreter = native.NewDefaultInterpreter()
+
+// Compare returns the fields of the rendered manifest that the live object does not match. The
+// fields the built-in retention rules keep from the member, e.g. the clusterIP of a Service, are
+// taken from the live object first, and fields only set on the live object are not reported, as
+// they are defaulted or added by the member cluster. The replicas of workloads labelled to retain
+// them, e.g. scaled by a HorizontalPodAutoscaler in the member, are not compared either.
+func Compare(desired, live *unstructured.Unstructured) ([]FieldDiff, error) {
+	desired = desired.DeepCopy()
+	if interpreter.HookEnabled(desired.GroupVersionKind(), configv1alpha1.InterpreterOperationRetain) {
+		retained, err := interpreter.Retain(desired, live)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retain the fields of %s: %w", desired.GetKind(), err)
+		}
+		desired = retained
+	}
+	if desired.GetLabels()[util.RetainReplicasLabel] == util.RetainReplicasValue {
+		unstructured.RemoveNestedField(desired.Object, "spec", "replicas")
+	}
+	diffs := make([]FieldDiff, 0)
+	diffValue("", desired.Object, live.Object, &diffs)
+	return diffs, nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func diffValue(path string, desired, live interface{}, diffs *[]FieldDiff) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffChanged, Desired: desired, Live: live})
+			return
+		}
+		keys := make([]string, 0, len(d))
+		for key := range d {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			p := joinPath(path, key)
+			if ignoredPaths[p] {
+				continue
+			}
+			lv, found := l[key]
+			if !found {
+				if !isEmpty(d[key]) {
+					*diffs = append(*diffs, FieldDiff{Path: p, Type: DiffMissing, Desired: d[key]})
+				}
+				continue
+			}
+			diffValue(p, d[key], lv, diffs)
+		}
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffChanged, Desired: desired, Live: live})
+			return
+		}
+		if names, ok := elementNames(d); ok {
+			diffNamedList(path, d, names, l, diffs)
+			return
+		}
+		if len(d) != len(l) {
+			*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffChanged, Desired: desired, Live: live})
+			return
+		}
+		for i := range d {
+			diffValue(fmt.Sprintf("%s[%d]", path, i), d[i], l[i], diffs)
+		}
+	default:
+		if !equalScalars(desired, live) {
+			*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffChanged, Desired: desired, Live: live})
+		}
+	}
+}
+
+// diffNamedList matches the elements of lists like containers or env by name, so that elements
+// the member added, e.g. by a mutating webhook, do not shift the comparison.
+func diffNamedList(path string, desired []interface{}, names []string, live []interface{}, diffs *[]FieldDiff) {
+	liveByName := make(map[string]interface{}, len(live))
+	for _, element := range live {
+		if m, ok := element.(map[string]interface{}); ok {
+			if name, ok := m["name"].(string); ok {
+				liveByName[name] = element
+			}
+		}
+	}
+	for i, name := range names {
+		p := fmt.Sprintf("%s[name=%s]", path, name)
+		lv, found := liveByName[name]
+		if !found {
+			*diffs = append(*diffs, FieldDiff{Path: p, Type: DiffMissing, Desired: desired[i]})
+			continue
+		}
+		diffValue(p, desired[i], lv, diffs)
+	}
+}
+
+// elementNames returns the names of the elements when every element is an object with a name.
+func elementNames(list []interface{}) ([]string, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+	names := make([]string, 0, len(list))
+	for _, element := range list {
+		m, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// equalScalars compares scalars, numbers are equal regardless of their decoded type.
+func equalScalars(a, b interface{}) bool {
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		return ok && af == bf
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
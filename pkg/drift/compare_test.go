@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCompare(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "sidecar", "image": "envoy:1"},
+						map[string]interface{}{"name": "app", "image": "nginx:1"},
+					},
+				},
+			},
+		},
+	}}
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default", "uid": "1", "resourceVersion": "5"},
+		"spec": map[string]interface{}{
+			"replicas":             float64(2),
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "nginx:2", "imagePullPolicy": "IfNotPresent"},
+						map[string]interface{}{"name": "sidecar", "image": "envoy:1"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(2)},
+	}}
+
+	diffs, err := Compare(desired, live)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+	if len(diffs) != 1 {
+		t.Fatalf("expected 1 diff, got %v", diffs)
+	}
+	if diffs[0].Path != "spec.template.spec.containers[name=app].image" || diffs[0].Type != DiffChanged {
+		t.Errorf("unexpected diff %+v", diffs[0])
+	}
+}
+
+func TestCompareMissingField(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "config", "labels": map[string]interface{}{"app": "nginx"}},
+		"data":       map[string]interface{}{"key": "value"},
+	}}
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "config"},
+		"data":       map[string]interface{}{"key": "value"},
+	}}
+
+	diffs, err := Compare(desired, live)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+	if len(diffs) != 1 || diffs[0].Path != "metadata.labels" || diffs[0].Type != DiffMissing {
+		t.Errorf("expected metadata.labels to be missing, got %v", diffs)
+	}
+}
+
+func TestCompareRetainedFields(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"clusterIP": "10.0.0.1",
+			"ports":     []interface{}{map[string]interface{}{"name": "http", "port": int64(80)}},
+		},
+	}}
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"clusterIP": "10.96.0.20",
+			"ports":     []interface{}{map[string]interface{}{"name": "http", "port": int64(80), "protocol": "TCP"}},
+		},
+	}}
+
+	diffs, err := Compare(desired, live)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("expected the retained clusterIP not to drift, got %v", diffs)
+	}
+}
+
+func TestCompareRetainedReplicas(t *testing.T) {
+	newDeployment := func(replicas int64, labels map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default", "labels": labels},
+			"spec":       map[string]interface{}{"replicas": replicas},
+		}}
+	}
+	retain := map[string]interface{}{"resourcetemplate.karmada.io/retain-replicas": "true"}
+
+	tests := []struct {
+		name      string
+		labels    map[string]interface{}
+		wantDiffs int
+	}{
+		{name: "replicas scaled in the member drift", labels: map[string]interface{}{"app": "nginx"}, wantDiffs: 1},
+		{name: "retained replicas do not drift", labels: retain},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs, err := Compare(newDeployment(2, tt.labels), newDeployment(5, tt.labels))
+			if err != nil {
+				t.Fatalf("Compare() error = %v", err)
+			}
+			if len(diffs) != tt.wantDiffs {
+				t.Errorf("expected %d diffs, got %v", tt.wantDiffs, diffs)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift detects member cluster objects that were changed outside of Karmada, by comparing
+// the manifests rendered into Works with the live objects read through the cluster proxy.
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+// ObjectDrift is the drift of a single object in a member cluster.
+type ObjectDrift struct {
+	Cluster    string `json:"cluster"`
+	Work       string `json:"work"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Drifted is true when the live object differs from the rendered manifest or is missing.
+	Drifted bool `json:"drifted"`
+	// Missing is true when the object does not exist in the member cluster.
+	Missing bool        `json:"missing"`
+	Diffs   []FieldDiff `json:"diffs"`
+	// Error is set when the object could not be checked, e.g. the cluster is unreachable.
+	Error string `json:"error,omitempty"`
+}
+
+// WorkloadDrift is the drift of a resource template in every member cluster it is propagated to.
+type WorkloadDrift struct {
+	Kind      string        `json:"kind"`
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+	CheckedAt metav1.Time   `json:"checkedAt"`
+	Objects   []ObjectDrift `json:"objects"`
+}
+
+// NamespaceSummary is the drift of every resource template of a namespace. Objects only lists the
+// objects that drifted or could not be checked.
+type NamespaceSummary struct {
+	Namespace string        `json:"namespace"`
+	CheckedAt metav1.Time   `json:"checkedAt"`
+	Checked   int           `json:"checked"`
+	Drifted   int           `json:"drifted"`
+	Missing   int           `json:"missing"`
+	Failed    int           `json:"failed"`
+	Objects   []ObjectDrift `json:"objects"`
+}
+
+// memberClient reads live objects from one member cluster through the cluster proxy.
+type memberClient struct {
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+}
+
+// memberRequestTimeout bounds each request to a member cluster, so that an unreachable cluster does
+// not stall a scan.
+const memberRequestTimeout = 10 * time.Second
+
+// newMemberClient builds the client of a member cluster, it is replaced in tests.
+var newMemberClient = func(cluster string) (*memberClient, error) {
+	dynamicClient, mapper, err := client.DynamicClientForMemberClusterWithTimeout(cluster, memberRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &memberClient{dynamicClient: dynamicClient, mapper: mapper}, nil
+}
+
+// getLiveObject reads the live counterpart of a rendered manifest.
+func (m *memberClient) getLiveObject(ctx context.Context, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := desired.GroupVersionKind()
+	mapping, err := m.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	return m.dynamicClient.Resource(mapping.Resource).Namespace(desired.GetNamespace()).Get(ctx, desired.GetName(), metav1.GetOptions{})
+}
+
+// memberClients builds the client of every member cluster at most once per check, so that the
+// discovery behind the REST mapper is shared by every manifest sent to the cluster. A cluster whose
+// request timed out is not asked again during the check.
+type memberClients struct {
+	clients map[string]*memberClient
+	errs    map[string]error
+}
+
+func newMemberClients() *memberClients {
+	return &memberClients{clients: make(map[string]*memberClient), errs: make(map[string]error)}
+}
+
+func (m *memberClients) get(cluster string) (*memberClient, error) {
+	if memberClient, ok := m.clients[cluster]; ok {
+		return memberClient, nil
+	}
+	if err, ok := m.errs[cluster]; ok {
+		return nil, err
+	}
+	memberClient, err := newMemberClient(cluster)
+	if err != nil {
+		m.errs[cluster] = err
+		return nil, err
+	}
+	m.clients[cluster] = memberClient
+	return memberClient, nil
+}
+
+// timedOut reports every later object of the cluster with err instead of waiting for it again.
+func (m *memberClients) timedOut(cluster string, err error) {
+	delete(m.clients, cluster)
+	m.errs[cluster] = err
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// CheckWorkload compares the manifests rendered for a resource template with the live objects in
+// every member cluster it is propagated to.
+func CheckWorkload(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, kind, name string) (*WorkloadDrift, error) {
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, names.GenerateBindingName(kind, name), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	objects, err := checkBinding(ctx, karmadaClient, newMemberClients(), binding)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadDrift{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		CheckedAt: metav1.Now(),
+		Objects:   objects,
+	}, nil
+}
+
+// CheckNamespace checks every resource template of the namespace that is propagated by a
+// ResourceBinding.
+func CheckNamespace(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace string) (*NamespaceSummary, error) {
+	return checkNamespace(ctx, karmadaClient, newMemberClients(), namespace)
+}
+
+func checkNamespace(ctx context.Context, karmadaClient karmadaclientset.Interface, clients *memberClients, namespace string) (*NamespaceSummary, error) {
+	bindings, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	summary := &NamespaceSummary{Namespace: namespace, Objects: make([]ObjectDrift, 0)}
+	for i := range bindings.Items {
+		objects, err := checkBinding(ctx, karmadaClient, clients, &bindings.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			summary.Checked++
+			switch {
+			case object.Error != "":
+				summary.Failed++
+			case object.Missing:
+				summary.Missing++
+			case object.Drifted:
+				summary.Drifted++
+			default:
+				continue
+			}
+			summary.Objects = append(summary.Objects, object)
+		}
+	}
+	summary.CheckedAt = metav1.Now()
+	return summary, nil
+}
+
+// checkBinding checks the manifests of every Work created for the binding.
+func checkBinding(ctx context.Context, karmadaClient karmadaclientset.Interface, clients *memberClients, binding *workv1alpha2.ResourceBinding) ([]ObjectDrift, error) {
+	objects := make([]ObjectDrift, 0)
+	id := binding.Labels[workv1alpha2.ResourceBindingPermanentIDLabel]
+	if id == "" {
+		return objects, nil
+	}
+	works, err := karmadaClient.WorkV1alpha1().Works(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{workv1alpha2.ResourceBindingPermanentIDLabel: id}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range works.Items {
+		objects = append(objects, checkWork(ctx, clients, &works.Items[i])...)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Cluster < objects[j].Cluster
+	})
+	return objects, nil
+}
+
+// checkWork compares every manifest of the Work with its live object.
+func checkWork(ctx context.Context, clients *memberClients, work *workv1alpha1.Work) []ObjectDrift {
+	cluster, err := names.GetClusterName(work.Namespace)
+	if err != nil {
+		return nil
+	}
+	objects := make([]ObjectDrift, 0, len(work.Spec.Workload.Manifests))
+	for _, manifest := range work.Spec.Workload.Manifests {
+		desired := &unstructured.Unstructured{}
+		if err := desired.UnmarshalJSON(manifest.Raw); err != nil {
+			objects = append(objects, ObjectDrift{Cluster: cluster, Work: work.Name, Error: fmt.Sprintf("invalid manifest: %v", err)})
+			continue
+		}
+		object := ObjectDrift{
+			Cluster:    cluster,
+			Work:       work.Name,
+			APIVersion: desired.GetAPIVersion(),
+			Kind:       desired.GetKind(),
+			Namespace:  desired.GetNamespace(),
+			Name:       desired.GetName(),
+			Diffs:      make([]FieldDiff, 0),
+		}
+		memberClient, err := clients.get(cluster)
+		if err != nil {
+			object.Error = err.Error()
+			objects = append(objects, object)
+			continue
+		}
+		live, err := memberClient.getLiveObject(ctx, desired)
+		switch {
+		case apierrors.IsNotFound(err):
+			object.Missing = true
+			object.Drifted = true
+		case isTimeout(err):
+			clients.timedOut(cluster, err)
+			object.Error = err.Error()
+		case err != nil:
+			object.Error = err.Error()
+		default:
+			diffs, err := Compare(desired, live)
+			if err != nil {
+				object.Error = err.Error()
+				break
+			}
+			object.Diffs = diffs
+			object.Drifted = len(diffs) > 0
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// Scanner checks namespaces on a schedule and keeps the latest summary of each.
+type Scanner struct {
+	karmadaClient karmadaclientset.Interface
+	namespaces    []string
+	interval      time.Duration
+	store         *summaryStore
+}
+
+// NewScanner returns a scanner checking the namespaces every interval, every namespace that has
+// ResourceBindings when namespaces is empty.
+func NewScanner(karmadaClient karmadaclientset.Interface, namespaces []string, interval time.Duration) *Scanner {
+	return &Scanner{karmadaClient: karmadaClient, namespaces: namespaces, interval: interval, store: lastSummaries}
+}
+
+// Run scans until the context is done.
+func (s *Scanner) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.scan(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scanner) scan(ctx context.Context) {
+	namespaces := s.namespaces
+	if len(namespaces) == 0 {
+		bindings, err := s.karmadaClient.WorkV1alpha2().ResourceBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Failed to list ResourceBindings for the drift scan")
+			return
+		}
+		seen := make(map[string]bool)
+		for _, binding := range bindings.Items {
+			if !seen[binding.Namespace] {
+				seen[binding.Namespace] = true
+				namespaces = append(namespaces, binding.Namespace)
+			}
+		}
+	}
+	// one scan shares the member cluster clients across namespaces
+	clients := newMemberClients()
+	for _, namespace := range namespaces {
+		summary, err := checkNamespace(ctx, s.karmadaClient, clients, namespace)
+		if err != nil {
+			klog.ErrorS(err, "Failed to scan namespace for drift", "namespace", namespace)
+			continue
+		}
+		s.store.set(summary)
+	}
+}
+
+// summaryStore keeps the latest summary of every scanned namespace.
+type summaryStore struct {
+	sync.RWMutex
+	items map[string]*NamespaceSummary
+}
+
+var lastSummaries = &summaryStore{items: make(map[string]*NamespaceSummary)}
+
+func (s *summaryStore) set(summary *NamespaceSummary) {
+	s.Lock()
+	defer s.Unlock()
+	s.items[summary.Namespace] = summary
+}
+
+func (s *summaryStore) get(namespace string) (*NamespaceSummary, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	summary, ok := s.items[namespace]
+	return summary, ok
+}
+
+// RecordSummary stores the summary as the latest one of its namespace.
+func RecordSummary(summary *NamespaceSummary) {
+	lastSummaries.set(summary)
+}
+
+// LastSummary returns the latest summary of the namespace, false when it has not been checked yet.
+func LastSummary(namespace string) (*NamespaceSummary, bool) {
+	return lastSummaries.get(namespace)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+const manifest = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"default"},"data":{"key":"value"}}`
+
+func newWork(cluster, id string) *workv1alpha1.Work {
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config-work-" + id,
+			Namespace: "karmada-es-" + cluster,
+			Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: id},
+		},
+		Spec: workv1alpha1.WorkSpec{Workload: workv1alpha1.WorkloadTemplate{
+			Manifests: []workv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(manifest)}}},
+		}},
+	}
+}
+
+func newBinding(name, id string) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: id},
+		},
+	}
+}
+
+func TestCheckNamespace(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(
+		newBinding("config-configmap", "id"), newWork("member1", "id"), newWork("member2", "id"), newWork("member3", "id"),
+		newBinding("other-configmap", "other"), newWork("member1", "other"),
+	)
+
+	desired := &unstructured.Unstructured{}
+	if err := desired.UnmarshalJSON([]byte(manifest)); err != nil {
+		t.Fatal(err)
+	}
+	changed := desired.DeepCopy()
+	_ = unstructured.SetNestedField(changed.Object, "changed", "data", "key")
+	liveObjects := map[string][]runtime.Object{
+		"member1": {desired},
+		"member2": {changed},
+		"member3": nil,
+	}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	built := make(map[string]int)
+	newMemberClient = func(cluster string) (*memberClient, error) {
+		built[cluster]++
+		return &memberClient{
+			dynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), liveObjects[cluster]...),
+			mapper:        mapper,
+		}, nil
+	}
+
+	summary, err := CheckNamespace(context.TODO(), karmadaClient, "default")
+	if err != nil {
+		t.Fatalf("CheckNamespace() error = %v", err)
+	}
+	if summary.Checked != 4 || summary.Drifted != 1 || summary.Missing != 1 || summary.Failed != 0 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if len(summary.Objects) != 2 || summary.Objects[0].Cluster != "member2" || summary.Objects[1].Cluster != "member3" {
+		t.Errorf("expected member2 and member3 to be reported, got %+v", summary.Objects)
+	}
+	if diffs := summary.Objects[0].Diffs; len(diffs) != 1 || diffs[0].Path != "data.key" {
+		t.Errorf("unexpected diffs %v", diffs)
+	}
+	for cluster, count := range built {
+		if count != 1 {
+			t.Errorf("client of %s built %d times, expected once per check", cluster, count)
+		}
+	}
+}
+
+func TestCheckNamespaceUnreachableCluster(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(newBinding("config-configmap", "id"), newWork("member1", "id"))
+	newMemberClient = func(cluster string) (*memberClient, error) {
+		return nil, fmt.Errorf("cluster %s is unreachable", cluster)
+	}
+
+	summary, err := CheckNamespace(context.TODO(), karmadaClient, "default")
+	if err != nil {
+		t.Fatalf("CheckNamespace() error = %v", err)
+	}
+	if summary.Failed != 1 || len(summary.Objects) != 1 || summary.Objects[0].Error != "cluster member1 is unreachable" {
+		t.Errorf("expected the object of member1 to fail, got %+v", summary)
+	}
+}
+
+func TestCheckNamespaceTimedOutCluster(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(
+		newBinding("config-configmap", "id"), newWork("member1", "id"),
+		newBinding("other-configmap", "other"), newWork("member1", "other"),
+	)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	requests := 0
+	newMemberClient = func(string) (*memberClient, error) {
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+		dynamicClient.PrependReactor("get", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+			requests++
+			return true, nil, &url.Error{Op: "Get", URL: "https://karmada-apiserver", Err: context.DeadlineExceeded}
+		})
+		return &memberClient{dynamicClient: dynamicClient, mapper: mapper}, nil
+	}
+
+	summary, err := CheckNamespace(context.TODO(), karmadaClient, "default")
+	if err != nil {
+		t.Fatalf("CheckNamespace() error = %v", err)
+	}
+	if summary.Failed != 2 {
+		t.Errorf("expected both objects of member1 to fail, got %+v", summary)
+	}
+	if requests != 1 {
+		t.Errorf("member1 was asked %d times after timing out, expected once", requests)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package informer
 
 import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
@@ -28,6 +29,9 @@ import (
 const (
 	// ResourceBindingByOwnerUID indexes ResourceBindings by ownerReferences UID.
 	ResourceBindingByOwnerUID = "byOwnerUID"
+	// ResourceBindingByPolicyID indexes ResourceBindings by the permanent ID of the PropagationPolicy
+	// or ClusterPropagationPolicy they were created from.
+	ResourceBindingByPolicyID = "byPolicyID"
 	// WorkByRBName indexes Works by annotation resourcebinding.karmada.io/name.
 	WorkByRBName = "byRBName"
 )
@@ -48,6 +52,17 @@ func Init(karmadaClient karmadaclientset.Interface, stopper <-chan struct{}) {
 			}
 			return keys, nil
 		},
+		ResourceBindingByPolicyID: func(obj interface{}) ([]string, error) {
+			rb := obj.(*workv1alpha2.ResourceBinding)
+			var keys []string
+			if id := rb.Labels[policyv1alpha1.PropagationPolicyPermanentIDLabel]; id != "" {
+				keys = append(keys, id)
+			}
+			if id := rb.Labels[policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel]; id != "" {
+				keys = append(keys, id)
+			}
+			return keys, nil
+		},
 	}); err != nil {
 		klog.Warningf("Failed to add ResourceBinding indexer: %v", err)
 	}
@@ -65,6 +80,9 @@ func Init(karmadaClient karmadaclientset.Interface, stopper <-chan struct{}) {
 		klog.Warningf("Failed to add Work indexer: %v", err)
 	}
 
+	// registered before Start so that the cluster informer is started along with the others
+	factory.Cluster().V1alpha1().Clusters().Informer()
+
 	factory.Start(stopper)
 	factory.WaitForCacheSync(stopper)
 	klog.InfoS("Karmada shared informer factory started and synced")
@@ -78,6 +96,11 @@ func sharedInformerFactory() karmadainformers.SharedInformerFactory {
 	return factory
 }
 
+// Started reports whether Init was called, callers that can do without the caches check it first.
+func Started() bool {
+	return factory != nil
+}
+
 // ResourceBindingIndexer returns the ResourceBinding indexer.
 func ResourceBindingIndexer() cache.Indexer {
 	return sharedInformerFactory().Work().V1alpha2().ResourceBindings().Informer().GetIndexer()
@@ -87,3 +110,8 @@ func ResourceBindingIndexer() cache.Indexer {
 func WorkIndexer() cache.Indexer {
 	return sharedInformerFactory().Work().V1alpha1().Works().Informer().GetIndexer()
 }
+
+// ClusterInformer returns the Cluster informer.
+func ClusterInformer() cache.SharedIndexInformer {
+	return sharedInformerFactory().Cluster().V1alpha1().Clusters().Informer()
+}
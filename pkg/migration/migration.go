@@ -0,0 +1,428 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration moves a workload from one member cluster to another by editing the cluster
+// names of its PropagationPolicy: the target cluster is added, and the source cluster is removed
+// once the replicas on the target are ready. Migrations are tracked as operations.
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// OperationType is the type of the operations tracking migrations.
+const OperationType = "MigrateWorkload"
+
+const (
+	stepAddTarget    = "Add target cluster to placement"
+	stepSurge        = "Scale up by the replica surge"
+	stepWait         = "Wait for the target cluster to become ready"
+	stepRemoveSource = "Remove source cluster from placement"
+	stepRestore      = "Restore replicas"
+)
+
+// pollInterval is how often the binding is checked while waiting, it is shortened in tests.
+var pollInterval = 2 * time.Second
+
+// errAborted is returned by a migration that was aborted.
+var errAborted = errors.New("the migration was aborted")
+
+// Options describes a migration.
+type Options struct {
+	Namespace     string
+	Kind          string
+	Name          string
+	SourceCluster string
+	TargetCluster string
+	// Surge is added to the replicas of a Deployment while it runs on both clusters, so that
+	// divided replicas do not drop below the original number on the source.
+	Surge int32
+	// Timeout bounds how long the target may take to become ready, paused time does not count.
+	Timeout time.Duration
+}
+
+// Progress is the result of a migration operation.
+type Progress struct {
+	PropagationPolicy string `json:"propagationPolicy"`
+	SourceCluster     string `json:"sourceCluster"`
+	TargetCluster     string `json:"targetCluster"`
+	Surge             int32  `json:"surge"`
+	Paused            bool   `json:"paused"`
+	// Target is the status of the workload on the target cluster while waiting for it.
+	Target *common.MemberStatus `json:"target,omitempty"`
+}
+
+// Migration is a running migration.
+type Migration struct {
+	karmadaClient karmadaclientset.Interface
+	k8sClient     kubernetes.Interface
+	opts          Options
+	policy        *policyv1alpha1.PropagationPolicy
+	tracker       *operation.Tracker
+
+	mu       sync.Mutex
+	progress Progress
+	resumed  chan struct{}
+	aborted  bool
+	cancel   context.CancelFunc
+}
+
+var (
+	mu         sync.Mutex
+	migrations = map[string]*Migration{}
+)
+
+// New validates the migration and registers its operation. The workload must be propagated by a
+// PropagationPolicy that selects only this workload and lists the source but not the target in its
+// cluster names, as the placement of every resource the policy selects changes.
+func New(ctx context.Context, karmadaClient karmadaclientset.Interface, k8sClient kubernetes.Interface, opts Options) (*Migration, error) {
+	if opts.SourceCluster == opts.TargetCluster {
+		return nil, apierrors.NewBadRequest("the source and target cluster must differ")
+	}
+	if opts.Surge > 0 && !strings.EqualFold(opts.Kind, "Deployment") {
+		return nil, apierrors.NewBadRequest("a replica surge is only supported for Deployments")
+	}
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(opts.Namespace).Get(ctx, names.GenerateBindingName(opts.Kind, opts.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	policyName := binding.Annotations[policyv1alpha1.PropagationPolicyNameAnnotation]
+	if policyName == "" {
+		return nil, apierrors.NewBadRequest("only workloads propagated by a PropagationPolicy can be migrated")
+	}
+	policy, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(opts.Namespace).Get(ctx, policyName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err = validatePolicy(policy, binding, opts); err != nil {
+		return nil, err
+	}
+
+	steps := []string{stepAddTarget, stepWait, stepRemoveSource}
+	if opts.Surge > 0 {
+		steps = []string{stepAddTarget, stepSurge, stepWait, stepRemoveSource, stepRestore}
+	}
+	m := &Migration{
+		karmadaClient: karmadaClient,
+		k8sClient:     k8sClient,
+		opts:          opts,
+		policy:        policy,
+		tracker:       operation.New(OperationType, fmt.Sprintf("%s/%s", opts.Namespace, opts.Name), steps...),
+		progress: Progress{
+			PropagationPolicy: policy.Name,
+			SourceCluster:     opts.SourceCluster,
+			TargetCluster:     opts.TargetCluster,
+			Surge:             opts.Surge,
+		},
+	}
+	m.publish()
+	mu.Lock()
+	defer mu.Unlock()
+	migrations[m.tracker.ID()] = m
+	return m, nil
+}
+
+func validatePolicy(policy *policyv1alpha1.PropagationPolicy, binding *workv1alpha2.ResourceBinding, opts Options) error {
+	selectors := policy.Spec.ResourceSelectors
+	if len(selectors) != 1 || selectors[0].Name != opts.Name || selectors[0].Kind != binding.Spec.Resource.Kind {
+		return apierrors.NewBadRequest(fmt.Sprintf("PropagationPolicy %s selects other resources as well, they would be migrated too", policy.Name))
+	}
+	affinity := policy.Spec.Placement.ClusterAffinity
+	if affinity == nil || len(policy.Spec.Placement.ClusterAffinities) > 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("PropagationPolicy %s does not place by cluster names", policy.Name))
+	}
+	if !slices.Contains(affinity.ClusterNames, opts.SourceCluster) {
+		return apierrors.NewBadRequest(fmt.Sprintf("PropagationPolicy %s does not place the workload on %s", policy.Name, opts.SourceCluster))
+	}
+	if slices.Contains(affinity.ClusterNames, opts.TargetCluster) {
+		return apierrors.NewBadRequest(fmt.Sprintf("PropagationPolicy %s already places the workload on %s", policy.Name, opts.TargetCluster))
+	}
+	return nil
+}
+
+// Get returns the running migration of the operation.
+func Get(id string) (*Migration, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	m, ok := migrations[id]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "migrations"}, id)
+	}
+	return m, nil
+}
+
+// Tracker returns the operation of the migration.
+func (m *Migration) Tracker() *operation.Tracker {
+	return m.tracker
+}
+
+// Pause holds the migration before its next step, and stops the timeout while waiting.
+func (m *Migration) Pause() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.resumed == nil {
+		m.resumed = make(chan struct{})
+		m.progress.Paused = true
+	}
+	m.publishLocked()
+}
+
+// Resume continues a paused migration.
+func (m *Migration) Resume() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.resumed != nil {
+		close(m.resumed)
+		m.resumed = nil
+		m.progress.Paused = false
+	}
+	m.publishLocked()
+}
+
+// Abort stops the migration, the placement and replicas are restored.
+func (m *Migration) Abort() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.aborted = true
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// Run runs the migration until it is done, and rolls it back when a step fails, the target does
+// not become ready within the timeout, or the migration is aborted.
+func (m *Migration) Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.mu.Lock()
+	m.cancel = cancel
+	aborted := m.aborted
+	m.mu.Unlock()
+	if aborted {
+		cancel()
+	}
+
+	err := m.run(ctx)
+	if err != nil && ctx.Err() != nil {
+		err = errAborted
+	}
+	m.tracker.Finish(err)
+	mu.Lock()
+	delete(migrations, m.tracker.ID())
+	mu.Unlock()
+	return err
+}
+
+func (m *Migration) run(ctx context.Context) error {
+	source, target := m.opts.SourceCluster, m.opts.TargetCluster
+	err := m.step(ctx, stepAddTarget, func() error {
+		original := m.policy.Spec.Placement.ClusterAffinity.ClusterNames
+		if err := m.updateClusterNames(ctx, func(clusters []string) []string {
+			return append(clusters, target)
+		}); err != nil {
+			return err
+		}
+		m.tracker.OnRollback("restore cluster names", func() error {
+			return m.updateClusterNames(context.TODO(), func([]string) []string {
+				return append([]string(nil), original...)
+			})
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.opts.Surge > 0 {
+		err = m.step(ctx, stepSurge, func() error {
+			scale, err := m.k8sClient.AppsV1().Deployments(m.opts.Namespace).GetScale(ctx, m.opts.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			original := scale.Spec.Replicas
+			if err = m.scale(ctx, original+m.opts.Surge); err != nil {
+				return err
+			}
+			m.tracker.OnRollback("restore replicas", func() error {
+				return m.scale(context.TODO(), original)
+			})
+			m.tracker.Note(stepSurge, fmt.Sprintf("scaled from %d to %d replicas", original, original+m.opts.Surge))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = m.step(ctx, stepWait, func() error { return m.waitForTarget(ctx) }); err != nil {
+		return err
+	}
+
+	err = m.step(ctx, stepRemoveSource, func() error {
+		return m.updateClusterNames(ctx, func(clusters []string) []string {
+			return slices.DeleteFunc(clusters, func(cluster string) bool { return cluster == source })
+		})
+	})
+	if err != nil || m.opts.Surge == 0 {
+		return err
+	}
+	return m.step(ctx, stepRestore, func() error {
+		scale, err := m.k8sClient.AppsV1().Deployments(m.opts.Namespace).GetScale(ctx, m.opts.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		return m.scale(ctx, scale.Spec.Replicas-m.opts.Surge)
+	})
+}
+
+// step runs fn as a step of the operation once the migration is not paused.
+func (m *Migration) step(ctx context.Context, name string, fn func() error) error {
+	if err := m.waitWhilePaused(ctx); err != nil {
+		return err
+	}
+	return m.tracker.Step(name, fn)
+}
+
+func (m *Migration) waitWhilePaused(ctx context.Context) error {
+	m.mu.Lock()
+	resumed := m.resumed
+	m.mu.Unlock()
+	if resumed == nil {
+		return nil
+	}
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// waitForTarget waits until the workload is applied, healthy and has all replicas ready on the
+// target cluster.
+func (m *Migration) waitForTarget(ctx context.Context) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	remaining := m.opts.Timeout
+	last := time.Now()
+	for {
+		ready, err := m.targetReady(ctx)
+		if err != nil {
+			return err
+		}
+		if ready {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			m.mu.Lock()
+			if m.resumed == nil {
+				remaining -= now.Sub(last)
+			}
+			m.mu.Unlock()
+			last = now
+			if remaining <= 0 {
+				return fmt.Errorf("the workload did not become ready on %s within %s", m.opts.TargetCluster, m.opts.Timeout)
+			}
+		}
+	}
+}
+
+func (m *Migration) targetReady(ctx context.Context) (bool, error) {
+	binding, err := m.karmadaClient.WorkV1alpha2().ResourceBindings(m.opts.Namespace).Get(ctx, names.GenerateBindingName(m.opts.Kind, m.opts.Name), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, status := range common.ToMemberStatuses(binding, nil) {
+		if status.Cluster != m.opts.TargetCluster {
+			continue
+		}
+		m.mu.Lock()
+		m.progress.Target = &status
+		m.publishLocked()
+		m.mu.Unlock()
+		m.tracker.Note(stepWait, fmt.Sprintf("%d/%d replicas ready on %s", status.ReadyReplicas, status.DesiredReplicas, status.Cluster))
+		return status.Applied && status.Health == string(workv1alpha2.ResourceHealthy) &&
+			status.ReadyReplicas >= status.DesiredReplicas, nil
+	}
+	m.tracker.Note(stepWait, fmt.Sprintf("the workload is not scheduled to %s yet", m.opts.TargetCluster))
+	return false, nil
+}
+
+func (m *Migration) updateClusterNames(ctx context.Context, mutate func([]string) []string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		policy, err := m.karmadaClient.PolicyV1alpha1().PropagationPolicies(m.policy.Namespace).Get(ctx, m.policy.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if policy.Spec.Placement.ClusterAffinity == nil {
+			return fmt.Errorf("PropagationPolicy %s no longer places by cluster names", policy.Name)
+		}
+		affinity := policy.Spec.Placement.ClusterAffinity
+		affinity.ClusterNames = mutate(append([]string(nil), affinity.ClusterNames...))
+		_, err = m.karmadaClient.PolicyV1alpha1().PropagationPolicies(policy.Namespace).Update(ctx, policy, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (m *Migration) scale(ctx context.Context, replicas int32) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		scale, err := m.k8sClient.AppsV1().Deployments(m.opts.Namespace).GetScale(ctx, m.opts.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		scale.Spec.Replicas = replicas
+		_, err = m.k8sClient.AppsV1().Deployments(m.opts.Namespace).UpdateScale(ctx, m.opts.Name, scale, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (m *Migration) publish() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.publishLocked()
+}
+
+// publishLocked sets a copy of the progress as operation result, callers must hold m.mu.
+func (m *Migration) publishLocked() {
+	progress := m.progress
+	if progress.Target != nil {
+		target := *progress.Target
+		progress.Target = &target
+	}
+	m.tracker.SetResult(&progress)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/operation"
+)
+
+func newClients(targetReady bool) *karmadafake.Clientset {
+	policy := &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-pp", Namespace: "default"},
+		Spec: policyv1alpha1.PropagationSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"}},
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+			},
+		},
+	}
+	readyReplicas := `{"replicas":2,"readyReplicas":1}`
+	if targetReady {
+		readyReplicas = `{"replicas":2,"readyReplicas":2}`
+	}
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nginx-deployment",
+			Namespace: "default",
+			Annotations: map[string]string{
+				policyv1alpha1.PropagationPolicyNameAnnotation:      "nginx-pp",
+				policyv1alpha1.PropagationPolicyNamespaceAnnotation: "default",
+			},
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 2}},
+		},
+		Status: workv1alpha2.ResourceBindingStatus{AggregatedStatus: []workv1alpha2.AggregatedStatusItem{{
+			ClusterName: "member2",
+			Applied:     true,
+			Health:      workv1alpha2.ResourceHealthy,
+			Status:      &runtime.RawExtension{Raw: []byte(readyReplicas)},
+		}}},
+	}
+	return karmadafake.NewSimpleClientset(policy, binding)
+}
+
+func clusterNames(t *testing.T, karmadaClient *karmadafake.Clientset) []string {
+	policy, err := karmadaClient.PolicyV1alpha1().PropagationPolicies("default").Get(context.TODO(), "nginx-pp", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return policy.Spec.Placement.ClusterAffinity.ClusterNames
+}
+
+func newMigration(t *testing.T, karmadaClient *karmadafake.Clientset, timeout time.Duration) *Migration {
+	m, err := New(context.TODO(), karmadaClient, fake.NewSimpleClientset(), Options{
+		Namespace:     "default",
+		Kind:          "Deployment",
+		Name:          "nginx",
+		SourceCluster: "member1",
+		TargetCluster: "member2",
+		Timeout:       timeout,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return m
+}
+
+func TestMigration(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	karmadaClient := newClients(true)
+	m := newMigration(t, karmadaClient, time.Second)
+
+	if err := m.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if names := clusterNames(t, karmadaClient); !slices.Equal(names, []string{"member2"}) {
+		t.Errorf("expected the workload to be placed on member2 only, got %v", names)
+	}
+	op := m.Tracker().Snapshot()
+	if op.Phase != operation.PhaseSucceeded {
+		t.Errorf("expected the operation to succeed, got %+v", op)
+	}
+	if progress := op.Result.(*Progress); progress.Target == nil || progress.Target.ReadyReplicas != 2 {
+		t.Errorf("expected the target status to be published, got %+v", progress)
+	}
+}
+
+func TestMigrationRollsBackOnTimeout(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	karmadaClient := newClients(false)
+	m := newMigration(t, karmadaClient, 50*time.Millisecond)
+
+	if err := m.Run(); err == nil {
+		t.Fatal("expected Run() to time out")
+	}
+	if names := clusterNames(t, karmadaClient); !slices.Equal(names, []string{"member1"}) {
+		t.Errorf("expected the placement to be restored, got %v", names)
+	}
+	if op := m.Tracker().Snapshot(); op.Phase != operation.PhaseFailed {
+		t.Errorf("expected the operation to fail, got %s", op.Phase)
+	}
+}
+
+func TestMigrationPauseAndAbort(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	karmadaClient := newClients(true)
+	m := newMigration(t, karmadaClient, time.Second)
+	m.Pause()
+
+	done := make(chan error)
+	go func() {
+		done <- m.Run()
+	}()
+	time.Sleep(50 * time.Millisecond)
+	if names := clusterNames(t, karmadaClient); !slices.Equal(names, []string{"member1"}) {
+		t.Errorf("expected a paused migration not to change the placement, got %v", names)
+	}
+	m.Abort()
+	if err := <-done; !errors.Is(err, errAborted) {
+		t.Errorf("expected the migration to be aborted, got %v", err)
+	}
+	if _, err := Get(m.Tracker().ID()); err == nil {
+		t.Errorf("expected a finished migration to be unregistered")
+	}
+}
+
+func TestNewRejectsSharedPolicy(t *testing.T) {
+	karmadaClient := newClients(true)
+	policy, _ := karmadaClient.PolicyV1alpha1().PropagationPolicies("default").Get(context.TODO(), "nginx-pp", metav1.GetOptions{})
+	policy.Spec.ResourceSelectors = append(policy.Spec.ResourceSelectors, policyv1alpha1.ResourceSelector{APIVersion: "v1", Kind: "Service", Name: "nginx"})
+	_, _ = karmadaClient.PolicyV1alpha1().PropagationPolicies("default").Update(context.TODO(), policy, metav1.UpdateOptions{})
+
+	_, err := New(context.TODO(), karmadaClient, fake.NewSimpleClientset(), Options{
+		Namespace: "default", Kind: "Deployment", Name: "nginx", SourceCluster: "member1", TargetCluster: "member2",
+	})
+	if err == nil {
+		t.Error("expected a policy selecting other resources to be rejected")
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operation tracks long-running, multi-step actions of the dashboard api, such as joining
+// a cluster, so that the UI can poll their progress. Every step that creates something can register
+// an undo function, and a failed operation undoes the registered steps in reverse order.
+package operation
+
+import (
+	"sort"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/klog/v2"
+)
+
+// Phase is the overall state of an operation.
+type Phase string
+
+const (
+	// PhaseRunning means the operation has not finished yet.
+	PhaseRunning Phase = "Running"
+	// PhaseSucceeded means every step succeeded.
+	PhaseSucceeded Phase = "Succeeded"
+	// PhaseFailed means a step failed, the rollback steps show what was undone.
+	PhaseFailed Phase = "Failed"
+)
+
+// StepStatus is the state of a single step.
+type StepStatus string
+
+const (
+	// StepPending means the step has not started.
+	StepPending StepStatus = "Pending"
+	// StepRunning means the step is in progress.
+	StepRunning StepStatus = "Running"
+	// StepSucceeded means the step finished successfully.
+	StepSucceeded StepStatus = "Succeeded"
+	// StepFailed means the step returned an error.
+	StepFailed StepStatus = "Failed"
+	// StepSkipped means the step never ran because an earlier step failed.
+	StepSkipped StepStatus = "Skipped"
+)
+
+// maxFinishedOperations bounds how many finished operations are kept in memory.
+const maxFinishedOperations = 100
+
+// Step is a single step of an operation.
+type Step struct {
+	Name           string       `json:"name"`
+	Status         StepStatus   `json:"status"`
+	Message        string       `json:"message,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// Operation is the tracked state of a long-running action.
+type Operation struct {
+	ID string `json:"id"`
+	// Type is the kind of action, e.g. "JoinCluster".
+	Type string `json:"type"`
+	// Target is the object the operation acts on, e.g. the cluster name.
+	Target string `json:"target"`
+	Phase  Phase  `json:"phase"`
+	Steps  []Step `json:"steps"`
+	Error  string `json:"error,omitempty"`
+	// Result is what the operation produced, e.g. a report, it is replaced as a whole on every update.
+	Result         interface{}  `json:"result,omitempty"`
+	StartTime      metav1.Time  `json:"startTime"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+type undo struct {
+	name string
+	fn   func() error
+}
+
+// Tracker records the progress of one operation.
+type Tracker struct {
+	mu        sync.Mutex
+	operation Operation
+	undos     []undo
+}
+
+var (
+	mu         sync.RWMutex
+	operations = map[string]*Tracker{}
+)
+
+// New registers a new running operation. The planned steps are listed as pending so the UI can show
+// the whole checklist from the start.
+func New(operationType, target string, plannedSteps ...string) *Tracker {
+	t := &Tracker{operation: Operation{
+		ID:        string(uuid.NewUUID()),
+		Type:      operationType,
+		Target:    target,
+		Phase:     PhaseRunning,
+		Steps:     make([]Step, 0, len(plannedSteps)),
+		StartTime: metav1.Now(),
+	}}
+	for _, name := range plannedSteps {
+		t.operation.Steps = append(t.operation.Steps, Step{Name: name, Status: StepPending})
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	operations[t.operation.ID] = t
+	prune()
+	return t
+}
+
+// Get returns a snapshot of the operation with the given id.
+func Get(id string) (*Operation, error) {
+	mu.RLock()
+	t, ok := operations[id]
+	mu.RUnlock()
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "operations"}, id)
+	}
+	return t.Snapshot(), nil
+}
+
+// ID returns the id of the operation.
+func (t *Tracker) ID() string {
+	return t.operation.ID
+}
+
+// Snapshot returns a copy of the current state of the operation.
+func (t *Tracker) Snapshot() *Operation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	snapshot := t.operation
+	snapshot.Steps = append([]Step(nil), t.operation.Steps...)
+	return &snapshot
+}
+
+// Step runs fn as the named step and records its outcome.
+func (t *Tracker) Step(name string, fn func() error) error {
+	t.setStep(name, StepRunning, "")
+	if err := fn(); err != nil {
+		t.setStep(name, StepFailed, err.Error())
+		return err
+	}
+	t.setStep(name, StepSucceeded, "")
+	return nil
+}
+
+// OnRollback registers fn to undo something a step created. Undo functions run in reverse
+// registration order when the operation fails.
+func (t *Tracker) OnRollback(name string, fn func() error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.undos = append(t.undos, undo{name: name, fn: fn})
+}
+
+// Finish completes the operation. When err is not nil, every registered undo function runs and is
+// recorded as a "Rollback: <name>" step, and the remaining pending steps are marked as skipped.
+func (t *Tracker) Finish(err error) {
+	if err != nil {
+		t.mu.Lock()
+		undos := t.undos
+		t.undos = nil
+		t.mu.Unlock()
+		for i := len(undos) - 1; i >= 0; i-- {
+			name := "Rollback: " + undos[i].name
+			if undoErr := t.Step(name, undos[i].fn); undoErr != nil {
+				klog.ErrorS(undoErr, "Failed to roll back", "operation", t.operation.ID, "step", undos[i].name)
+			}
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := metav1.Now()
+	t.operation.CompletionTime = &now
+	t.operation.Phase = PhaseSucceeded
+	if err != nil {
+		t.operation.Phase = PhaseFailed
+		t.operation.Error = err.Error()
+		for i := range t.operation.Steps {
+			if t.operation.Steps[i].Status == StepPending {
+				t.operation.Steps[i].Status = StepSkipped
+			}
+		}
+	}
+}
+
+// SetResult replaces the result of the operation. The result must not be modified afterwards as
+// snapshots share it.
+func (t *Tracker) SetResult(result interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.operation.Result = result
+}
+
+// Note attaches an informational message to the named step, e.g. what a step left behind.
+func (t *Tracker) Note(name, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.step(name).Message = message
+}
+
+func (t *Tracker) setStep(name string, status StepStatus, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := metav1.Now()
+	step := t.step(name)
+	step.Status = status
+	// keep the note of a succeeded step, a new run or a failure replaces it
+	if status != StepSucceeded {
+		step.Message = message
+	}
+	switch status {
+	case StepRunning:
+		step.StartTime = &now
+	case StepSucceeded, StepFailed:
+		step.CompletionTime = &now
+	}
+}
+
+// step returns the named step, adding it when it was not planned, callers must hold t.mu.
+func (t *Tracker) step(name string) *Step {
+	for i := range t.operation.Steps {
+		if t.operation.Steps[i].Name == name {
+			return &t.operation.Steps[i]
+		}
+	}
+	t.operation.Steps = append(t.operation.Steps, Step{Name: name})
+	return &t.operation.Steps[len(t.operation.Steps)-1]
+}
+
+// prune drops the oldest finished operations beyond maxFinishedOperations, callers must hold mu.
+func prune() {
+	finished := make([]*Operation, 0)
+	for _, t := range operations {
+		if snapshot := t.Snapshot(); snapshot.CompletionTime != nil {
+			finished = append(finished, snapshot)
+		}
+	}
+	if len(finished) <= maxFinishedOperations {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].CompletionTime.Before(finished[j].CompletionTime)
+	})
+	for _, op := range finished[:len(finished)-maxFinishedOperations] {
+		delete(operations, op.ID)
+	}
+}
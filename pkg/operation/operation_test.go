@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestTrackerRollsBackInReverseOrder(t *testing.T) {
+	tracker := New("Test", "member1", "create a", "create b", "wait")
+	var undone []string
+	for _, name := range []string{"a", "b"} {
+		name := name
+		if err := tracker.Step("create "+name, func() error {
+			tracker.OnRollback(name, func() error {
+				undone = append(undone, name)
+				return nil
+			})
+			return nil
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	failure := errors.New("boom")
+	if err := tracker.Step("wait", func() error { return failure }); !errors.Is(err, failure) {
+		t.Fatalf("expected step error %v, got %v", failure, err)
+	}
+	tracker.Finish(failure)
+
+	if len(undone) != 2 || undone[0] != "b" || undone[1] != "a" {
+		t.Fatalf("expected rollback order [b a], got %v", undone)
+	}
+	op, err := Get(tracker.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if op.Phase != PhaseFailed || op.Error != "boom" || op.CompletionTime == nil {
+		t.Fatalf("unexpected operation state: %+v", op)
+	}
+	expected := []struct {
+		name   string
+		status StepStatus
+	}{
+		{"create a", StepSucceeded},
+		{"create b", StepSucceeded},
+		{"wait", StepFailed},
+		{"Rollback: b", StepSucceeded},
+		{"Rollback: a", StepSucceeded},
+	}
+	if len(op.Steps) != len(expected) {
+		t.Fatalf("expected %d steps, got %+v", len(expected), op.Steps)
+	}
+	for i, step := range expected {
+		if op.Steps[i].Name != step.name || op.Steps[i].Status != step.status {
+			t.Errorf("step %d: expected %s/%s, got %s/%s", i, step.name, step.status, op.Steps[i].Name, op.Steps[i].Status)
+		}
+	}
+}
+
+func TestTrackerSkipsPendingStepsOnFailure(t *testing.T) {
+	tracker := New("Test", "member1", "first", "second")
+	failure := errors.New("boom")
+	_ = tracker.Step("first", func() error { return failure })
+	tracker.Finish(failure)
+
+	op := tracker.Snapshot()
+	if op.Steps[1].Status != StepSkipped {
+		t.Errorf("expected pending step to be skipped, got %s", op.Steps[1].Status)
+	}
+}
+
+func TestTrackerSucceeds(t *testing.T) {
+	tracker := New("Test", "member1", "only")
+	tracker.OnRollback("never", func() error {
+		t.Fatal("rollback must not run for a successful operation")
+		return nil
+	})
+	_ = tracker.Step("only", func() error { return nil })
+	tracker.Finish(nil)
+
+	if op := tracker.Snapshot(); op.Phase != PhaseSucceeded {
+		t.Errorf("expected phase %s, got %s", PhaseSucceeded, op.Phase)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	if _, err := Get("missing"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
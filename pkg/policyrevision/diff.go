@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrevision
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+)
+
+// RevisionDiff is a unified diff between the specs of two revisions of the same policy.
+type RevisionDiff struct {
+	Policy PolicyRef `json:"policy"`
+	From   *Revision `json:"from"`
+	To     *Revision `json:"to"`
+	// Diff is the unified diff of the YAML rendered specs, empty when they are identical.
+	Diff string `json:"diff"`
+}
+
+// DiffRevisions returns the diff between revisions from and to of the policy.
+func DiffRevisions(ctx context.Context, ref PolicyRef, from, to int64) (*RevisionDiff, error) {
+	fromRevision, err := GetRevision(ctx, ref, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := GetRevision(ctx, ref, to)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := diffRevisionSpecs(fromRevision, toRevision)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionDiff{
+		Policy: ref,
+		From:   fromRevision,
+		To:     toRevision,
+		Diff:   diff,
+	}, nil
+}
+
+func diffRevisionSpecs(from, to *Revision) (string, error) {
+	fromYAML, err := yaml.Marshal(from.Spec)
+	if err != nil {
+		return "", err
+	}
+	toYAML, err := yaml.Marshal(to.Spec)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fromYAML)),
+		B:        difflib.SplitLines(string(toYAML)),
+		FromFile: fmt.Sprintf("revision-%d", from.Revision),
+		ToFile:   fmt.Sprintf("revision-%d", to.Revision),
+		Context:  3,
+	})
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrevision
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+)
+
+const (
+	// maxRevisions bounds the number of revisions kept per policy.
+	maxRevisions = 20
+
+	// ManagedByLabel marks ConfigMaps that hold policy revisions.
+	ManagedByLabel = "dashboard.karmada.io/policy-revisions"
+	// PolicyKindLabel records the kind of the policy the revisions belong to.
+	PolicyKindLabel = "dashboard.karmada.io/policy-kind"
+	// PolicyNamespaceAnnotation records the namespace of the policy the revisions belong to.
+	PolicyNamespaceAnnotation = "dashboard.karmada.io/policy-namespace"
+	// PolicyNameAnnotation records the name of the policy the revisions belong to.
+	PolicyNameAnnotation = "dashboard.karmada.io/policy-name"
+)
+
+// PolicyKind is the kind of policy whose revisions are tracked.
+type PolicyKind string
+
+// PolicyKind constants define the policy kinds that support revision history.
+const (
+	PropagationPolicyKind        PolicyKind = "PropagationPolicy"
+	ClusterPropagationPolicyKind PolicyKind = "ClusterPropagationPolicy"
+)
+
+// PolicyRef identifies a PropagationPolicy or ClusterPropagationPolicy.
+type PolicyRef struct {
+	Kind      PolicyKind `json:"kind"`
+	Namespace string     `json:"namespace,omitempty"`
+	Name      string     `json:"name"`
+}
+
+func (ref PolicyRef) key() string {
+	return fmt.Sprintf("%s/%s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
+
+// Revision is a snapshot of a policy spec saved when the policy was changed through the dashboard.
+type Revision struct {
+	// Revision is the sequence number of the revision, starting from 1.
+	Revision int64 `json:"revision"`
+	// Author is the user who made the change, empty if unknown.
+	Author string `json:"author"`
+	// Timestamp is the time the revision was recorded.
+	Timestamp metav1.Time `json:"timestamp"`
+	// Spec is the full policy spec at this revision.
+	Spec policyv1alpha1.PropagationSpec `json:"spec"`
+}
+
+// RevisionNumber implements configmapstore.Revision.
+func (r Revision) RevisionNumber() int64 {
+	return r.Revision
+}
+
+// RevisionList contains the revisions of a single policy, newest first.
+type RevisionList struct {
+	Policy    PolicyRef  `json:"policy"`
+	Revisions []Revision `json:"revisions"`
+}
+
+var store = configmapstore.NewRevisionStore[Revision](
+	configmapstore.New("policy revision", "karmada-dashboard-policy-revisions-", ManagedByLabel), maxRevisions)
+
+// Init sets the client and namespace used to persist policy revisions.
+func Init(k8sClient kubernetes.Interface, namespace string) {
+	store.Init(k8sClient, namespace)
+}
+
+// ListRevisions returns all stored revisions of the policy, newest first.
+func ListRevisions(ctx context.Context, ref PolicyRef) (*RevisionList, error) {
+	revisions, err := store.List(ctx, ref.key())
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionList{Policy: ref, Revisions: revisions}, nil
+}
+
+// GetRevision returns a single revision of the policy.
+func GetRevision(ctx context.Context, ref PolicyRef, revision int64) (*Revision, error) {
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Revisions {
+		if list.Revisions[i].Revision == revision {
+			return &list.Revisions[i], nil
+		}
+	}
+	return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), fmt.Sprintf("%s %s revision %d", ref.Kind, ref.Name, revision))
+}
+
+// RecordRevision saves spec as a new revision of the policy. Nothing is recorded when spec equals
+// the latest revision. The returned revision is the latest one after the call.
+func RecordRevision(ctx context.Context, ref PolicyRef, author string, spec policyv1alpha1.PropagationSpec) (*Revision, error) {
+	recorded, err := store.Record(ctx, ref.key(),
+		map[string]string{PolicyKindLabel: string(ref.Kind)},
+		map[string]string{PolicyNamespaceAnnotation: ref.Namespace, PolicyNameAnnotation: ref.Name},
+		func(latest Revision) bool {
+			return equality.Semantic.DeepEqual(latest.Spec, spec)
+		},
+		func(number int64) Revision {
+			return Revision{Revision: number, Author: author, Timestamp: metav1.NewTime(time.Now()), Spec: spec}
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &recorded, nil
+}
+
+// EnsureBaseline records spec as the first revision when the policy has no history yet, so that
+// the state before the first dashboard edit can be rolled back to.
+func EnsureBaseline(ctx context.Context, ref PolicyRef, spec policyv1alpha1.PropagationSpec) error {
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if len(list.Revisions) > 0 {
+		return nil
+	}
+	_, err = RecordRevision(ctx, ref, "", spec)
+	return err
+}
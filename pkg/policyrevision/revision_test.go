@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrevision
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func specWithClusters(names ...string) policyv1alpha1.PropagationSpec {
+	return policyv1alpha1.PropagationSpec{
+		Placement: policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: names},
+		},
+	}
+}
+
+func TestRecordRevision(t *testing.T) {
+	Init(fake.NewSimpleClientset(), "karmada-dashboard")
+	ctx := context.TODO()
+	ref := PolicyRef{Kind: PropagationPolicyKind, Namespace: "default", Name: "nginx"}
+
+	first, err := RecordRevision(ctx, ref, "alice", specWithClusters("member1"))
+	if err != nil {
+		t.Fatalf("RecordRevision() error = %v", err)
+	}
+	if first.Revision != 1 || first.Author != "alice" {
+		t.Errorf("RecordRevision() = revision %d by %q, expected revision 1 by alice", first.Revision, first.Author)
+	}
+
+	// an unchanged spec must not create a new revision
+	same, err := RecordRevision(ctx, ref, "bob", specWithClusters("member1"))
+	if err != nil {
+		t.Fatalf("RecordRevision() error = %v", err)
+	}
+	if same.Revision != 1 {
+		t.Errorf("RecordRevision() with unchanged spec = revision %d, expected 1", same.Revision)
+	}
+
+	if _, err = RecordRevision(ctx, ref, "bob", specWithClusters("member2")); err != nil {
+		t.Fatalf("RecordRevision() error = %v", err)
+	}
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		t.Fatalf("ListRevisions() error = %v", err)
+	}
+	if len(list.Revisions) != 2 || list.Revisions[0].Revision != 2 {
+		t.Errorf("ListRevisions() = %+v, expected revisions [2 1]", list.Revisions)
+	}
+}
+
+func TestRecordRevisionPrunesOldest(t *testing.T) {
+	Init(fake.NewSimpleClientset(), "karmada-dashboard")
+	ctx := context.TODO()
+	ref := PolicyRef{Kind: ClusterPropagationPolicyKind, Name: "global"}
+
+	for i := 0; i < maxRevisions+5; i++ {
+		spec := specWithClusters(strings.Repeat("m", i+1))
+		if _, err := RecordRevision(ctx, ref, "", spec); err != nil {
+			t.Fatalf("RecordRevision() error = %v", err)
+		}
+	}
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		t.Fatalf("ListRevisions() error = %v", err)
+	}
+	if len(list.Revisions) != maxRevisions {
+		t.Fatalf("ListRevisions() returned %d revisions, expected %d", len(list.Revisions), maxRevisions)
+	}
+	if newest, oldest := list.Revisions[0].Revision, list.Revisions[maxRevisions-1].Revision; newest != maxRevisions+5 || oldest != 6 {
+		t.Errorf("ListRevisions() kept revisions %d..%d, expected 6..%d", oldest, newest, maxRevisions+5)
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	Init(fake.NewSimpleClientset(), "karmada-dashboard")
+	ctx := context.TODO()
+	ref := PolicyRef{Kind: PropagationPolicyKind, Namespace: "default", Name: "nginx"}
+	for _, cluster := range []string{"member1", "member2"} {
+		if _, err := RecordRevision(ctx, ref, "", specWithClusters(cluster)); err != nil {
+			t.Fatalf("RecordRevision() error = %v", err)
+		}
+	}
+
+	diff, err := DiffRevisions(ctx, ref, 1, 2)
+	if err != nil {
+		t.Fatalf("DiffRevisions() error = %v", err)
+	}
+	for _, line := range []string{"--- revision-1", "+++ revision-2", "-    - member1", "+    - member2"} {
+		if !strings.Contains(diff.Diff, line) {
+			t.Errorf("DiffRevisions() diff does not contain %q:\n%s", line, diff.Diff)
+		}
+	}
+
+	if _, err = DiffRevisions(ctx, ref, 1, 3); err == nil {
+		t.Errorf("DiffRevisions() against a missing revision expected an error")
+	}
+}
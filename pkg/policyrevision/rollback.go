@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrevision
+
+import (
+	"context"
+	"fmt"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
+)
+
+// RollbackResult is the outcome of rolling a policy back to a previous revision.
+type RollbackResult struct {
+	// Preview lists the bindings that would move when the target revision is applied.
+	Preview *propagationpolicy.MatchPreview `json:"preview"`
+	// Applied is false for dry-runs.
+	Applied bool `json:"applied"`
+	// Revision is the new revision recorded by the rollback, nil for dry-runs.
+	Revision *Revision `json:"revision,omitempty"`
+}
+
+// Rollback restores the spec of the policy to the given revision. The match preview of the target
+// spec is always computed first; when dryRun is true nothing else happens.
+func Rollback(ctx context.Context, karmadaClient karmadaclientset.Interface, ref PolicyRef,
+	revision int64, author string, dryRun bool) (*RollbackResult, error) {
+	target, err := GetRevision(ctx, ref, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := getPolicy(ctx, karmadaClient, ref)
+	if err != nil {
+		return nil, err
+	}
+	idLabel := policyv1alpha1.PropagationPolicyPermanentIDLabel
+	if ref.Kind == ClusterPropagationPolicyKind {
+		idLabel = policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel
+	}
+	preview, err := propagationpolicy.GetMatchPreview(ctx, karmadaClient, idLabel, current.GetLabels()[idLabel], target.Spec.Placement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview revision %d: %w", revision, err)
+	}
+
+	result := &RollbackResult{Preview: preview}
+	if dryRun {
+		return result, nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, getErr := getPolicy(ctx, karmadaClient, ref)
+		if getErr != nil {
+			return getErr
+		}
+		return updatePolicySpec(ctx, karmadaClient, latest, target.Spec)
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to roll back policy", "kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name, "revision", revision)
+		return nil, err
+	}
+	result.Applied = true
+
+	recorded, err := RecordRevision(ctx, ref, author, target.Spec)
+	if err != nil {
+		// the rollback itself succeeded, only the bookkeeping failed
+		klog.Warningf("Policy %s/%s rolled back to revision %d but the new revision was not recorded: %v", ref.Namespace, ref.Name, revision, err)
+		return result, nil
+	}
+	result.Revision = recorded
+	return result, nil
+}
+
+func getPolicy(ctx context.Context, karmadaClient karmadaclientset.Interface, ref PolicyRef) (metav1.Object, error) {
+	if ref.Kind == ClusterPropagationPolicyKind {
+		return karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+	return karmadaClient.PolicyV1alpha1().PropagationPolicies(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+}
+
+func updatePolicySpec(ctx context.Context, karmadaClient karmadaclientset.Interface, policy metav1.Object, spec policyv1alpha1.PropagationSpec) error {
+	var err error
+	switch p := policy.(type) {
+	case *policyv1alpha1.ClusterPropagationPolicy:
+		p.Spec = spec
+		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, p, metav1.UpdateOptions{})
+	case *policyv1alpha1.PropagationPolicy:
+		p.Spec = spec
+		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(p.Namespace).Update(ctx, p, metav1.UpdateOptions{})
+	default:
+		err = fmt.Errorf("unsupported policy type %T", policy)
+	}
+	return err
+}
@@ -0,0 +1,534 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package promote adopts objects that already run in a member cluster into the Karmada control
+// plane, the way `karmadactl promote` does: the objects become resource templates that are
+// propagated back to the source cluster, overwriting the existing objects in place.
+package promote
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+// ObjectRef identifies a namespaced object, Kind is the kind as it appears in the object, e.g.
+// Deployment.
+type ObjectRef struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+func (r ObjectRef) String() string {
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// Candidate is a member object that can be promoted.
+type Candidate struct {
+	ObjectRef
+	// Dependency is true for objects that were not selected but are used by a selected object.
+	Dependency bool `json:"dependency"`
+	// RequiredBy lists the selected objects that use the dependency.
+	RequiredBy []string `json:"requiredBy,omitempty"`
+	// Managed is true when the control plane already has a resource template of that name, the
+	// object can not be promoted then.
+	Managed bool `json:"managed"`
+	// Template is the resource template that would be created, with member specific fields removed.
+	Template *unstructured.Unstructured `json:"template"`
+}
+
+// PromotedObject is an object that was promoted, together with the PropagationPolicy pinning it to
+// the source cluster.
+type PromotedObject struct {
+	ObjectRef
+	PropagationPolicy string `json:"propagationPolicy"`
+}
+
+// Result is the result of a promotion.
+type Result struct {
+	Cluster    string           `json:"cluster"`
+	PromotedAt metav1.Time      `json:"promotedAt"`
+	Objects    []PromotedObject `json:"objects"`
+	// Skipped lists the dependencies that were not promoted as the control plane already has them,
+	// e.g. a Secret shared with a workload promoted before.
+	Skipped []ObjectRef `json:"skipped"`
+}
+
+// Verification tells whether a promoted object was applied to the source cluster without its pods
+// being recreated.
+type Verification struct {
+	ObjectRef
+	Applied bool   `json:"applied"`
+	Message string `json:"message,omitempty"`
+	// Pods is the number of pods of a workload, RecreatedPods lists the ones created after the
+	// promotion.
+	Pods          int      `json:"pods"`
+	RecreatedPods []string `json:"recreatedPods"`
+	// Verified is true when the object is applied and none of its pods were recreated.
+	Verified bool `json:"verified"`
+}
+
+// removedAnnotations are written by member controllers and clients and are not part of the template.
+var removedAnnotations = []string{
+	corev1.LastAppliedConfigAnnotation,
+	"deployment.kubernetes.io/revision",
+}
+
+// jobControllerLabels are generated for the pods of a Job, the member keeps them for the existing
+// Job as Karmada retains the selector of Jobs.
+var jobControllerLabels = []string{"controller-uid", "batch.kubernetes.io/controller-uid"}
+
+// Plan returns the member objects to promote, with the ConfigMaps, Secrets and Services they use
+// when includeDependencies is set.
+func Plan(ctx context.Context, memberClient kubernetes.Interface, memberVerber, karmadaVerber client.ResourceVerber,
+	refs []ObjectRef, includeDependencies bool) ([]Candidate, error) {
+	candidates := make([]Candidate, 0, len(refs))
+	index := make(map[ObjectRef]int)
+	add := func(candidate Candidate) {
+		if i, ok := index[candidate.ObjectRef]; ok {
+			candidates[i].RequiredBy = append(candidates[i].RequiredBy, candidate.RequiredBy...)
+			return
+		}
+		index[candidate.ObjectRef] = len(candidates)
+		candidates = append(candidates, candidate)
+	}
+
+	for _, ref := range refs {
+		obj, err := getObject(memberVerber, ref)
+		if err != nil {
+			return nil, err
+		}
+		add(Candidate{ObjectRef: ref, Template: obj})
+	}
+	if includeDependencies {
+		for _, ref := range refs {
+			deps, err := dependencies(ctx, memberClient, candidates[index[ref]].Template)
+			if err != nil {
+				return nil, err
+			}
+			for _, dep := range deps {
+				obj, err := getObject(memberVerber, dep)
+				if apierrors.IsNotFound(err) {
+					// optional references may point to objects that do not exist
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				add(Candidate{ObjectRef: dep, Dependency: true, RequiredBy: []string{ref.String()}, Template: obj})
+			}
+		}
+	}
+
+	for i := range candidates {
+		candidates[i].Template = StripObject(candidates[i].Template)
+		_, err := karmadaVerber.Get(strings.ToLower(candidates[i].Kind), candidates[i].Namespace, candidates[i].Name)
+		switch {
+		case err == nil:
+			candidates[i].Managed = true
+		case !apierrors.IsNotFound(err):
+			return nil, err
+		}
+	}
+	return candidates, nil
+}
+
+// PromotionRefs returns the candidates of a plan to promote, and the dependencies skipped because
+// the control plane already manages them.
+func PromotionRefs(candidates []Candidate) (refs, skipped []ObjectRef) {
+	refs = make([]ObjectRef, 0, len(candidates))
+	skipped = make([]ObjectRef, 0)
+	for _, candidate := range candidates {
+		if candidate.Dependency && candidate.Managed {
+			skipped = append(skipped, candidate.ObjectRef)
+			continue
+		}
+		refs = append(refs, candidate.ObjectRef)
+	}
+	return refs, skipped
+}
+
+// Promote creates resource templates for the member objects, each with a PropagationPolicy pinned
+// to the source cluster that overwrites the existing object. Like `karmadactl promote`, it creates
+// the namespaces of the objects that the control plane does not have yet, k8sClient is the client
+// of the Karmada apiserver. Everything created is deleted again when a step fails, the policies
+// preserve the member objects so that deleting an adopted template leaves them running.
+func Promote(ctx context.Context, k8sClient kubernetes.Interface, karmadaClient karmadaclientset.Interface,
+	memberVerber, karmadaVerber client.ResourceVerber, cluster string, refs []ObjectRef) (*Result, error) {
+	templates := make([]*unstructured.Unstructured, 0, len(refs))
+	policies := make([]*policyv1alpha1.PropagationPolicy, 0, len(refs))
+	for _, ref := range refs {
+		obj, err := getObject(memberVerber, ref)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = karmadaVerber.Get(strings.ToLower(ref.Kind), ref.Namespace, ref.Name); err == nil {
+			return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: strings.ToLower(ref.Kind)}, fmt.Sprintf("%s/%s", ref.Namespace, ref.Name))
+		} else if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		template := StripObject(obj)
+		templates = append(templates, template)
+		policies = append(policies, buildPolicy(template, cluster))
+	}
+
+	result := &Result{Cluster: cluster, Objects: make([]PromotedObject, 0, len(refs)), Skipped: make([]ObjectRef, 0)}
+	var createdNamespaces []string
+	var createdPolicies []*policyv1alpha1.PropagationPolicy
+	var createdTemplates []*unstructured.Unstructured
+	rollback := func(cause error) error {
+		for _, template := range createdTemplates {
+			if err := karmadaVerber.Delete(strings.ToLower(template.GetKind()), template.GetNamespace(), template.GetName(), false); err != nil {
+				klog.ErrorS(err, "Failed to roll back promoted resource template", "kind", template.GetKind(),
+					"namespace", template.GetNamespace(), "name", template.GetName())
+			}
+		}
+		for _, policy := range createdPolicies {
+			if err := karmadaClient.PolicyV1alpha1().PropagationPolicies(policy.Namespace).Delete(ctx, policy.Name, metav1.DeleteOptions{}); err != nil {
+				klog.ErrorS(err, "Failed to roll back PropagationPolicy of promotion", "namespace", policy.Namespace, "name", policy.Name)
+			}
+		}
+		for _, namespace := range createdNamespaces {
+			if err := k8sClient.CoreV1().Namespaces().Delete(ctx, namespace, metav1.DeleteOptions{}); err != nil {
+				klog.ErrorS(err, "Failed to roll back namespace of promotion", "namespace", namespace)
+			}
+		}
+		return fmt.Errorf("promotion was rolled back: %w", cause)
+	}
+
+	for _, namespace := range templateNamespaces(templates) {
+		created, err := ensureNamespace(ctx, k8sClient, namespace)
+		if err != nil {
+			return nil, rollback(err)
+		}
+		if created {
+			createdNamespaces = append(createdNamespaces, namespace)
+		}
+	}
+
+	// The policies are created first, so that no other policy claims the templates meanwhile.
+	for _, policy := range policies {
+		created, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(policy.Namespace).Create(ctx, policy, metav1.CreateOptions{})
+		if err != nil {
+			return nil, rollback(err)
+		}
+		createdPolicies = append(createdPolicies, created)
+	}
+	result.PromotedAt = metav1.Now()
+	for i, template := range templates {
+		created, err := karmadaVerber.Create(template)
+		if err != nil {
+			return nil, rollback(err)
+		}
+		createdTemplates = append(createdTemplates, created)
+		result.Objects = append(result.Objects, PromotedObject{ObjectRef: refs[i], PropagationPolicy: createdPolicies[i].Name})
+	}
+	return result, nil
+}
+
+// templateNamespaces returns the namespaces of the templates, in order of first use.
+func templateNamespaces(templates []*unstructured.Unstructured) []string {
+	seen := make(map[string]bool)
+	namespaces := make([]string, 0)
+	for _, template := range templates {
+		if namespace := template.GetNamespace(); namespace != "" && !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// ensureNamespace creates the namespace in the control plane unless it exists, created tells
+// whether it was created.
+func ensureNamespace(ctx context.Context, k8sClient kubernetes.Interface, namespace string) (bool, error) {
+	_, err := k8sClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err == nil {
+		return false, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	_, err = k8sClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Verify checks that a promoted object was applied to the source cluster, and that the pods of a
+// workload were not recreated since the promotion.
+func Verify(ctx context.Context, karmadaClient karmadaclientset.Interface, memberClient kubernetes.Interface, memberVerber client.ResourceVerber,
+	cluster string, ref ObjectRef, since time.Time) (*Verification, error) {
+	verification := &Verification{ObjectRef: ref, RecreatedPods: make([]string, 0)}
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(ref.Namespace).Get(ctx, names.GenerateBindingName(ref.Kind, ref.Name), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		verification.Message = "the resource template is not bound yet"
+	case err != nil:
+		return nil, err
+	default:
+		verification.Message = "the Work is not applied yet"
+		for _, item := range binding.Status.AggregatedStatus {
+			if item.ClusterName == cluster {
+				verification.Applied = item.Applied
+				verification.Message = item.AppliedMessage
+			}
+		}
+	}
+
+	obj, err := getObject(memberVerber, ref)
+	if err != nil {
+		return nil, err
+	}
+	selector, found, err := unstructured.NestedStringMap(obj.Object, "spec", "selector", "matchLabels")
+	if err != nil {
+		return nil, err
+	}
+	if found && len(selector) > 0 {
+		pods, err := memberClient.CoreV1().Pods(ref.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(selector).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		verification.Pods = len(pods.Items)
+		for _, pod := range pods.Items {
+			if pod.CreationTimestamp.Time.After(since) {
+				verification.RecreatedPods = append(verification.RecreatedPods, pod.Name)
+			}
+		}
+		sort.Strings(verification.RecreatedPods)
+	}
+	verification.Verified = verification.Applied && len(verification.RecreatedPods) == 0
+	return verification, nil
+}
+
+// StripObject returns a copy of the member object without the fields set by the member apiserver
+// and controllers, suitable as resource template.
+func StripObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	template := obj.DeepCopy()
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+		"deletionGracePeriodSeconds", "managedFields", "selfLink", "ownerReferences", "finalizers"} {
+		unstructured.RemoveNestedField(template.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(template.Object, "status")
+	if annotations := template.GetAnnotations(); annotations != nil {
+		for _, key := range removedAnnotations {
+			delete(annotations, key)
+		}
+		template.SetAnnotations(annotations)
+	}
+
+	switch template.GetKind() {
+	case "Service":
+		// cluster IPs are allocated by the member, Karmada retains them when applying the template
+		if clusterIP, _, _ := unstructured.NestedString(template.Object, "spec", "clusterIP"); clusterIP != corev1.ClusterIPNone {
+			unstructured.RemoveNestedField(template.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(template.Object, "spec", "clusterIPs")
+		}
+	case "Job":
+		unstructured.RemoveNestedField(template.Object, "spec", "selector")
+		for _, label := range jobControllerLabels {
+			unstructured.RemoveNestedField(template.Object, "spec", "template", "metadata", "labels", label)
+		}
+	}
+	return template
+}
+
+// buildPolicy returns the PropagationPolicy that pins the template to the source cluster, named the
+// way `karmadactl promote` names it. The template takes over the member object as soon as it is
+// created, so the member object is preserved when the template is deleted, be it on rollback or later.
+func buildPolicy(template *unstructured.Unstructured, cluster string) *policyv1alpha1.PropagationPolicy {
+	gvk := template.GroupVersionKind()
+	return &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: template.GetNamespace(),
+			Name:      names.GeneratePolicyName(template.GetNamespace(), template.GetName(), gvk.String()),
+		},
+		Spec: policyv1alpha1.PropagationSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Name:       template.GetName(),
+			}},
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{cluster}},
+			},
+			ConflictResolution:          policyv1alpha1.ConflictOverwrite,
+			PreserveResourcesOnDeletion: ptr.To(true),
+		},
+	}
+}
+
+func getObject(verber client.ResourceVerber, ref ObjectRef) (*unstructured.Unstructured, error) {
+	obj, err := verber.Get(strings.ToLower(ref.Kind), ref.Namespace, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T for %s", obj, ref)
+	}
+	return u, nil
+}
+
+// podTemplatePaths are the fields holding the pod template of each workload kind.
+var podTemplatePaths = map[string][]string{
+	"Deployment":  {"spec", "template"},
+	"StatefulSet": {"spec", "template"},
+	"DaemonSet":   {"spec", "template"},
+	"ReplicaSet":  {"spec", "template"},
+	"Job":         {"spec", "template"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template"},
+}
+
+// dependencies returns the ConfigMaps and Secrets the pod template of a workload uses, and the
+// Services selecting its pods.
+func dependencies(ctx context.Context, memberClient kubernetes.Interface, obj *unstructured.Unstructured) ([]ObjectRef, error) {
+	path, ok := podTemplatePaths[obj.GetKind()]
+	if !ok {
+		return nil, nil
+	}
+	content, found, err := unstructured.NestedMap(obj.Object, path...)
+	if err != nil || !found {
+		return nil, err
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(content, template); err != nil {
+		return nil, err
+	}
+
+	namespace := obj.GetNamespace()
+	refs := make([]ObjectRef, 0)
+	for _, name := range podConfigMaps(&template.Spec) {
+		refs = append(refs, ObjectRef{Kind: "ConfigMap", Namespace: namespace, Name: name})
+	}
+	for _, name := range podSecrets(&template.Spec) {
+		refs = append(refs, ObjectRef{Kind: "Secret", Namespace: namespace, Name: name})
+	}
+
+	services, err := memberClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	serviceName, _, _ := unstructured.NestedString(obj.Object, "spec", "serviceName")
+	for _, service := range services.Items {
+		selected := len(service.Spec.Selector) > 0 &&
+			labels.SelectorFromSet(service.Spec.Selector).Matches(labels.Set(template.Labels))
+		if selected || service.Name == serviceName {
+			refs = append(refs, ObjectRef{Kind: "Service", Namespace: namespace, Name: service.Name})
+		}
+	}
+	return refs, nil
+}
+
+func podConfigMaps(spec *corev1.PodSpec) []string {
+	set := make(map[string]bool)
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			set[volume.ConfigMap.Name] = true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					set[source.ConfigMap.Name] = true
+				}
+			}
+		}
+	}
+	for _, container := range allContainers(spec) {
+		for _, env := range container.EnvFrom {
+			if env.ConfigMapRef != nil {
+				set[env.ConfigMapRef.Name] = true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil {
+				set[env.ValueFrom.ConfigMapKeyRef.Name] = true
+			}
+		}
+	}
+	return sortedNames(set)
+}
+
+func podSecrets(spec *corev1.PodSpec) []string {
+	set := make(map[string]bool)
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			set[volume.Secret.SecretName] = true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					set[source.Secret.Name] = true
+				}
+			}
+		}
+	}
+	for _, container := range allContainers(spec) {
+		for _, env := range container.EnvFrom {
+			if env.SecretRef != nil {
+				set[env.SecretRef.Name] = true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				set[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+	}
+	for _, secret := range spec.ImagePullSecrets {
+		set[secret.Name] = true
+	}
+	return sortedNames(set)
+}
+
+func allContainers(spec *corev1.PodSpec) []corev1.Container {
+	containers := make([]corev1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	containers = append(containers, spec.InitContainers...)
+	return append(containers, spec.Containers...)
+}
+
+func sortedNames(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for name := range set {
+		if name != "" {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// fakeVerber keeps objects in memory, keyed by lower-case kind, namespace and name.
+type fakeVerber struct {
+	objects   map[string]*unstructured.Unstructured
+	createErr error
+	// createErrs fails the creation of single objects, keyed like objects
+	createErrs map[string]error
+}
+
+func newFakeVerber(objects ...*unstructured.Unstructured) *fakeVerber {
+	v := &fakeVerber{objects: make(map[string]*unstructured.Unstructured)}
+	for _, obj := range objects {
+		v.objects[key(strings.ToLower(obj.GetKind()), obj.GetNamespace(), obj.GetName())] = obj
+	}
+	return v
+}
+
+func key(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func (v *fakeVerber) Update(object *unstructured.Unstructured) error {
+	v.objects[key(strings.ToLower(object.GetKind()), object.GetNamespace(), object.GetName())] = object
+	return nil
+}
+
+func (v *fakeVerber) Get(kind string, namespace string, name string) (runtime.Object, error) {
+	obj, ok := v.objects[key(kind, namespace, name)]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: kind}, name)
+	}
+	return obj, nil
+}
+
+func (v *fakeVerber) Delete(kind string, namespace string, name string, _ bool) error {
+	delete(v.objects, key(kind, namespace, name))
+	return nil
+}
+
+func (v *fakeVerber) Create(object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	k := key(strings.ToLower(object.GetKind()), object.GetNamespace(), object.GetName())
+	if v.createErr != nil {
+		return nil, v.createErr
+	}
+	if err := v.createErrs[k]; err != nil {
+		return nil, err
+	}
+	v.objects[k] = object
+	return object, nil
+}
+
+func (v *fakeVerber) Table(string, string) (*metav1.Table, error) {
+	return nil, nil
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object, kind string) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion("v1")
+	if kind == "Deployment" {
+		u.SetAPIVersion("apps/v1")
+	}
+	u.SetKind(kind)
+	return u
+}
+
+func newDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "nginx",
+			"namespace":       "default",
+			"uid":             "1",
+			"resourceVersion": "10",
+			"annotations":     map[string]interface{}{"deployment.kubernetes.io/revision": "3", "team": "web"},
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "nginx"}},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "nginx"}},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{
+						"name":    "nginx",
+						"image":   "nginx",
+						"envFrom": []interface{}{map[string]interface{}{"configMapRef": map[string]interface{}{"name": "nginx-config"}}},
+					}},
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "tls", "secret": map[string]interface{}{"secretName": "nginx-tls"}},
+						map[string]interface{}{"name": "extra", "configMap": map[string]interface{}{"name": "absent"}},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}}
+}
+
+func TestPlan(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "nginx"}, ClusterIP: "10.96.0.10"},
+	}
+	memberClient := fake.NewSimpleClientset(service, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "other"}},
+	})
+	memberVerber := newFakeVerber(newDeployment(),
+		toUnstructured(t, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "nginx-config", Namespace: "default"}}, "ConfigMap"),
+		toUnstructured(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "nginx-tls", Namespace: "default"}}, "Secret"),
+		toUnstructured(t, service, "Service"))
+	karmadaVerber := newFakeVerber(toUnstructured(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "nginx-tls", Namespace: "default"}}, "Secret"))
+
+	candidates, err := Plan(context.TODO(), memberClient, memberVerber, karmadaVerber,
+		[]ObjectRef{{Kind: "Deployment", Namespace: "default", Name: "nginx"}}, true)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	got := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		got = append(got, fmt.Sprintf("%s dependency=%t managed=%t", candidate.ObjectRef, candidate.Dependency, candidate.Managed))
+	}
+	want := []string{
+		"Deployment default/nginx dependency=false managed=false",
+		"ConfigMap default/nginx-config dependency=true managed=false",
+		"Secret default/nginx-tls dependency=true managed=true",
+		"Service default/nginx dependency=true managed=false",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Plan() = %v, want %v", got, want)
+	}
+
+	deployment := candidates[0].Template
+	if deployment.GetUID() != "" || deployment.GetResourceVersion() != "" || deployment.Object["status"] != nil {
+		t.Errorf("expected member fields to be removed, got %v", deployment.Object)
+	}
+	if annotations := deployment.GetAnnotations(); len(annotations) != 1 || annotations["team"] != "web" {
+		t.Errorf("unexpected annotations %v", annotations)
+	}
+	if _, found, _ := unstructured.NestedString(candidates[3].Template.Object, "spec", "clusterIP"); found {
+		t.Errorf("expected the clusterIP to be removed")
+	}
+}
+
+func TestPromotionRefs(t *testing.T) {
+	deployment := ObjectRef{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	config := ObjectRef{Kind: "ConfigMap", Namespace: "default", Name: "nginx-config"}
+	tls := ObjectRef{Kind: "Secret", Namespace: "default", Name: "nginx-tls"}
+	refs, skipped := PromotionRefs([]Candidate{
+		{ObjectRef: deployment, Managed: true},
+		{ObjectRef: config, Dependency: true},
+		{ObjectRef: tls, Dependency: true, Managed: true},
+	})
+	// a managed selected object is still promoted, so that the conflict is reported
+	if len(refs) != 2 || refs[0] != deployment || refs[1] != config {
+		t.Errorf("PromotionRefs() refs = %v, want [%s %s]", refs, deployment, config)
+	}
+	if len(skipped) != 1 || skipped[0] != tls {
+		t.Errorf("PromotionRefs() skipped = %v, want [%s]", skipped, tls)
+	}
+}
+
+func TestPromote(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	karmadaClient := karmadafake.NewSimpleClientset()
+	memberVerber := newFakeVerber(newDeployment())
+	karmadaVerber := newFakeVerber()
+
+	result, err := Promote(context.TODO(), k8sClient, karmadaClient, memberVerber, karmadaVerber, "member1",
+		[]ObjectRef{{Kind: "Deployment", Namespace: "default", Name: "nginx"}})
+	if err != nil {
+		t.Fatalf("Promote() error = %v", err)
+	}
+	if len(result.Objects) != 1 {
+		t.Fatalf("expected 1 promoted object, got %v", result.Objects)
+	}
+	policy, err := karmadaClient.PolicyV1alpha1().PropagationPolicies("default").Get(context.TODO(), result.Objects[0].PropagationPolicy, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the PropagationPolicy to be created: %v", err)
+	}
+	if policy.Spec.ConflictResolution != "Overwrite" || policy.Spec.Placement.ClusterAffinity.ClusterNames[0] != "member1" ||
+		policy.Spec.PreserveResourcesOnDeletion == nil || !*policy.Spec.PreserveResourcesOnDeletion {
+		t.Errorf("unexpected PropagationPolicy spec %+v", policy.Spec)
+	}
+	if _, err = karmadaVerber.Get("deployment", "default", "nginx"); err != nil {
+		t.Errorf("expected the resource template to be created: %v", err)
+	}
+
+	// promoting again fails as the template exists
+	if _, err = Promote(context.TODO(), k8sClient, karmadaClient, memberVerber, karmadaVerber, "member1",
+		[]ObjectRef{{Kind: "Deployment", Namespace: "default", Name: "nginx"}}); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an AlreadyExists error, got %v", err)
+	}
+}
+
+func TestPromoteRollback(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset()
+	karmadaVerber := newFakeVerber()
+	karmadaVerber.createErr = fmt.Errorf("denied")
+
+	k8sClient := fake.NewSimpleClientset()
+
+	_, err := Promote(context.TODO(), k8sClient, karmadaClient, newFakeVerber(newDeployment()), karmadaVerber, "member1",
+		[]ObjectRef{{Kind: "Deployment", Namespace: "default", Name: "nginx"}})
+	if err == nil {
+		t.Fatal("expected Promote() to fail")
+	}
+	policies, _ := karmadaClient.PolicyV1alpha1().PropagationPolicies("default").List(context.TODO(), metav1.ListOptions{})
+	if len(policies.Items) != 0 {
+		t.Errorf("expected the PropagationPolicy to be deleted again, got %d", len(policies.Items))
+	}
+	if _, err = k8sClient.CoreV1().Namespaces().Get(context.TODO(), "default", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the created namespace to be deleted again, got %v", err)
+	}
+}
+
+func TestPromoteRollbackPreservesAdoptedObjects(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	karmadaClient := karmadafake.NewSimpleClientset()
+	var policies []*policyv1alpha1.PropagationPolicy
+	karmadaClient.PrependReactor("create", "propagationpolicies", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		policies = append(policies, action.(clienttesting.CreateAction).GetObject().(*policyv1alpha1.PropagationPolicy))
+		return false, nil, nil
+	})
+	config := toUnstructured(t, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "nginx-config", Namespace: "default"}}, "ConfigMap")
+	memberVerber := newFakeVerber(newDeployment(), config)
+	karmadaVerber := newFakeVerber()
+	karmadaVerber.createErrs = map[string]error{key("configmap", "default", "nginx-config"): fmt.Errorf("denied")}
+
+	_, err := Promote(context.TODO(), k8sClient, karmadaClient, memberVerber, karmadaVerber, "member1", []ObjectRef{
+		{Kind: "Deployment", Namespace: "default", Name: "nginx"},
+		{Kind: "ConfigMap", Namespace: "default", Name: "nginx-config"},
+	})
+	if err == nil {
+		t.Fatal("expected Promote() to fail on the second object")
+	}
+	if _, err = karmadaVerber.Get("deployment", "default", "nginx"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the first resource template to be deleted again, got %v", err)
+	}
+	// the Deployment template already took over the member object, deleting it must not delete the
+	// member Deployment
+	if len(policies) != 2 {
+		t.Fatalf("expected 2 PropagationPolicies to be created, got %d", len(policies))
+	}
+	for _, policy := range policies {
+		if policy.Spec.PreserveResourcesOnDeletion == nil || !*policy.Spec.PreserveResourcesOnDeletion {
+			t.Errorf("expected PropagationPolicy %s to preserve the member objects", policy.Name)
+		}
+	}
+	remaining, _ := karmadaClient.PolicyV1alpha1().PropagationPolicies("default").List(context.TODO(), metav1.ListOptions{})
+	if len(remaining.Items) != 0 {
+		t.Errorf("expected the PropagationPolicies to be deleted again, got %d", len(remaining.Items))
+	}
+	if _, err = memberVerber.Get("deployment", "default", "nginx"); err != nil {
+		t.Errorf("expected the member Deployment to be kept: %v", err)
+	}
+}
+
+func TestPromoteCreatesMissingNamespace(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	karmadaVerber := newFakeVerber()
+
+	_, err := Promote(context.TODO(), k8sClient, karmadafake.NewSimpleClientset(), newFakeVerber(newDeployment()), karmadaVerber, "member1",
+		[]ObjectRef{{Kind: "Deployment", Namespace: "default", Name: "nginx"}})
+	if err != nil {
+		t.Fatalf("Promote() error = %v", err)
+	}
+	if _, err = k8sClient.CoreV1().Namespaces().Get(context.TODO(), "default", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected the namespace to be created in the control plane: %v", err)
+	}
+	if _, err = karmadaVerber.Get("deployment", "default", "nginx"); err != nil {
+		t.Errorf("expected the resource template to be created: %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	promotedAt := time.Now()
+	karmadaClient := karmadafake.NewSimpleClientset(&workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: names.GenerateBindingName("Deployment", "nginx"), Namespace: "default"},
+		Status: workv1alpha2.ResourceBindingStatus{AggregatedStatus: []workv1alpha2.AggregatedStatusItem{
+			{ClusterName: "member1", Applied: true},
+		}},
+	})
+	newPod := func(name string, created time.Time) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "default", Labels: map[string]string{"app": "nginx"}, CreationTimestamp: metav1.NewTime(created),
+		}}
+	}
+	memberClient := fake.NewSimpleClientset(newPod("nginx-a", promotedAt.Add(-time.Hour)), newPod("nginx-b", promotedAt.Add(-time.Hour)))
+	ref := ObjectRef{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	verification, err := Verify(context.TODO(), karmadaClient, memberClient, newFakeVerber(newDeployment()), "member1", ref, promotedAt)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !verification.Verified || verification.Pods != 2 {
+		t.Errorf("expected the promotion to be verified, got %+v", verification)
+	}
+
+	_, _ = memberClient.CoreV1().Pods("default").Create(context.TODO(), newPod("nginx-c", promotedAt.Add(time.Minute)), metav1.CreateOptions{})
+	verification, err = Verify(context.TODO(), karmadaClient, memberClient, newFakeVerber(newDeployment()), "member1", ref, promotedAt)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if verification.Verified || len(verification.RecreatedPods) != 1 || verification.RecreatedPods[0] != "nginx-c" {
+		t.Errorf("expected nginx-c to be reported as recreated, got %+v", verification)
+	}
+}
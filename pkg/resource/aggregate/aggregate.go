@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package aggregate lists resources of many member clusters as a single list.
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+const (
+	// DefaultConcurrency is how many clusters are queried at the same time by default.
+	DefaultConcurrency = 10
+	// DefaultTimeout bounds the requests to a single cluster by default.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Options selects the member clusters to query and how.
+type Options struct {
+	// ClusterSelector is a label selector of the clusters, every cluster when empty.
+	ClusterSelector string
+	// Clusters narrows the query to the named clusters, every selected cluster when empty.
+	Clusters    []string
+	Concurrency int
+	Timeout     time.Duration
+}
+
+// memberClient returns the client for a member cluster, replaced in tests.
+var memberClient = client.ClientForMemberClusterWithTimeout
+
+// clusterItems are the items listed from a single cluster.
+type clusterItems[T any] struct {
+	cluster string
+	items   []T
+}
+
+// targetClusters returns the Ready clusters chosen by the options, and an error for each chosen
+// cluster that is not Ready.
+func targetClusters(ctx context.Context, karmadaClient karmadaclientset.Interface, opts Options) ([]string, []error, error) {
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{LabelSelector: opts.ClusterSelector})
+	if err != nil {
+		return nil, nil, err
+	}
+	wanted := make(map[string]bool, len(opts.Clusters))
+	for _, name := range opts.Clusters {
+		wanted[name] = true
+	}
+	targets := make([]string, 0, len(clusters.Items))
+	nonCriticalErrors := make([]error, 0)
+	for _, cluster := range clusters.Items {
+		if len(wanted) > 0 && !wanted[cluster.Name] {
+			continue
+		}
+		if !meta.IsStatusConditionTrue(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady) {
+			nonCriticalErrors = append(nonCriticalErrors, errors.NewInternal(fmt.Sprintf("cluster %s is skipped, it is not Ready", cluster.Name)))
+			continue
+		}
+		targets = append(targets, cluster.Name)
+	}
+	sort.Strings(targets)
+	return targets, nonCriticalErrors, nil
+}
+
+// fanOut lists the items of every target cluster with bounded concurrency. Clusters that fail or
+// time out are reported as non-critical errors, the items of the other clusters are still returned.
+func fanOut[T any](ctx context.Context, karmadaClient karmadaclientset.Interface, opts Options,
+	list func(client kubernetes.Interface) ([]T, []error, error)) ([]clusterItems[T], []error, error) {
+	targets, nonCriticalErrors, err := targetClusters(ctx, karmadaClient, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	results := make([]clusterItems[T], len(targets))
+	clusterErrors := make([][]error, len(targets))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, cluster := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i].cluster = cluster
+			memberClusterClient, err := memberClient(cluster, timeout)
+			if err != nil {
+				clusterErrors[i] = []error{errors.NewInternal(fmt.Sprintf("cluster %s: %v", cluster, err))}
+				return
+			}
+			items, listErrors, err := list(memberClusterClient)
+			if err != nil {
+				clusterErrors[i] = []error{errors.NewInternal(fmt.Sprintf("cluster %s: %v", cluster, err))}
+				return
+			}
+			results[i].items = items
+			clusterErrors[i] = listErrors
+		}()
+	}
+	wg.Wait()
+	nonCriticalErrors = errors.MergeErrors(append([][]error{nonCriticalErrors}, clusterErrors...)...)
+	if nonCriticalErrors == nil {
+		nonCriticalErrors = make([]error, 0)
+	}
+	return results, nonCriticalErrors, nil
+}
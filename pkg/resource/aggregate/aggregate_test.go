@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+func newCluster(name string, ready bool) *clusterv1alpha1.Cluster {
+	status := metav1.ConditionFalse
+	if ready {
+		status = metav1.ConditionTrue
+	}
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"env": "prod"}},
+		Status: clusterv1alpha1.ClusterStatus{
+			Conditions: []metav1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: status}},
+		},
+	}
+}
+
+func newService(name string) *corev1.Service {
+	return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
+
+func TestGetServiceList(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(
+		newCluster("member1", true),
+		newCluster("member2", true),
+		newCluster("member3", false),
+		newCluster("member4", true),
+	)
+	memberClients := map[string]kubernetes.Interface{
+		"member1": kubefake.NewSimpleClientset(newService("b")),
+		"member2": kubefake.NewSimpleClientset(newService("a"), newService("c")),
+	}
+	original := memberClient
+	defer func() { memberClient = original }()
+	memberClient = func(cluster string, _ time.Duration) (kubernetes.Interface, error) {
+		if c, ok := memberClients[cluster]; ok {
+			return c, nil
+		}
+		return nil, fmt.Errorf("unreachable")
+	}
+
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination,
+		dataselect.NewSortQuery([]string{"a", string(dataselect.NameProperty)}), dataselect.NoFilter)
+	list, err := GetServiceList(context.TODO(), karmadaClient, Options{}, common.NewNamespaceQuery(nil), dsQuery)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.ListMeta.TotalItems != 3 || len(list.Items) != 3 {
+		t.Fatalf("expected 3 services, got %d", list.ListMeta.TotalItems)
+	}
+	for i, expected := range []struct{ name, cluster string }{{"a", "member2"}, {"b", "member1"}, {"c", "member2"}} {
+		if list.Items[i].ObjectMeta.Name != expected.name || list.Items[i].Cluster != expected.cluster {
+			t.Errorf("item %d: expected %s/%s, got %s/%s", i, expected.cluster, expected.name,
+				list.Items[i].Cluster, list.Items[i].ObjectMeta.Name)
+		}
+	}
+	// member3 is not Ready and member4 cannot be reached.
+	if len(list.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got %v", list.Errors)
+	}
+
+	list, err = GetServiceList(context.TODO(), karmadaClient, Options{Clusters: []string{"member1"}, Concurrency: 1},
+		common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Cluster != "member1" || len(list.Errors) != 0 {
+		t.Fatalf("expected only the service of member1, got %+v", list)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregate
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// The code below allows to perform complex data section on items of many clusters
+
+// ItemCell is an item of an aggregated list for data selection, Index points at the item.
+type ItemCell struct {
+	ObjectMeta types.ObjectMeta
+	Cluster    string
+	Status     string
+	// Reason, FirstSeen and LastSeen are only set for events.
+	Reason    string
+	FirstSeen metav1.Time
+	LastSeen  metav1.Time
+	Index     int
+}
+
+// GetProperty returns a property.
+func (c ItemCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableString(c.Cluster)
+	case dataselect.StatusProperty, dataselect.TypeProperty:
+		return dataselect.StdComparableString(c.Status)
+	case dataselect.ReasonProperty:
+		return dataselect.StdComparableString(c.Reason)
+	case dataselect.FirstSeenProperty:
+		return dataselect.StdComparableTime(c.FirstSeen.Time)
+	case dataselect.LastSeenProperty:
+		return dataselect.StdComparableTime(c.LastSeen.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// selectItems applies the data select query to the items, toCell describes the item at the index.
+func selectItems[T any](items []T, toCell func(index int, item T) ItemCell, dsQuery *dataselect.DataSelectQuery) ([]T, int) {
+	cells := make([]dataselect.DataCell, len(items))
+	for i := range items {
+		cells[i] = toCell(i, items[i])
+	}
+	selected, filteredTotal := dataselect.GenericDataSelectWithFilter(cells, dsQuery)
+	result := make([]T, len(selected))
+	for i := range selected {
+		result[i] = items[selected[i].(ItemCell).Index]
+	}
+	return result, filteredTotal
+}
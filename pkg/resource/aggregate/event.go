@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregate
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/client-go/kubernetes"
+
+	errorsutil "github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/event"
+)
+
+// Event is an event of a member cluster.
+type Event struct {
+	common.Event `json:",inline"`
+	Cluster      string `json:"cluster"`
+}
+
+// EventList contains the events of many member clusters.
+type EventList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []Event        `json:"items"`
+
+	// List of non-critical errors, e.g. the clusters that could not be reached.
+	Errors []error `json:"errors"`
+}
+
+// GetEventList returns the events of the member clusters chosen by the options.
+func GetEventList(ctx context.Context, karmadaClient karmadaclientset.Interface, opts Options,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*EventList, error) {
+	results, nonCriticalErrors, err := fanOut(ctx, karmadaClient, opts, func(memberClient kubernetes.Interface) ([]common.Event, []error, error) {
+		channels := &common.ResourceChannels{
+			EventList: common.GetEventListChannel(memberClient, nsQuery, 1),
+		}
+		events := <-channels.EventList.List
+		listErrors, err := errorsutil.ExtractErrors(<-channels.EventList.Error)
+		if err != nil {
+			return nil, nil, err
+		}
+		items := make([]common.Event, 0)
+		if events != nil {
+			for _, item := range event.FillEventsType(events.Items) {
+				items = append(items, event.ToEvent(item))
+			}
+		}
+		return items, listErrors, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Event, 0)
+	for _, result := range results {
+		for _, item := range result.items {
+			items = append(items, Event{Event: item, Cluster: result.cluster})
+		}
+	}
+	items, filteredTotal := selectItems(items, func(index int, item Event) ItemCell {
+		return ItemCell{
+			ObjectMeta: item.ObjectMeta,
+			Cluster:    item.Cluster,
+			Status:     item.Type,
+			Reason:     item.Reason,
+			FirstSeen:  item.FirstSeen,
+			LastSeen:   item.LastSeen,
+			Index:      index,
+		}
+	}, dsQuery)
+	return &EventList{
+		ListMeta: types.ListMeta{TotalItems: filteredTotal},
+		Items:    items,
+		Errors:   nonCriticalErrors,
+	}, nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregate
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/service"
+)
+
+// Service is a service of a member cluster.
+type Service struct {
+	service.Service `json:",inline"`
+	Cluster         string `json:"cluster"`
+}
+
+// ServiceList contains the services of many member clusters.
+type ServiceList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []Service      `json:"items"`
+
+	// List of non-critical errors, e.g. the clusters that could not be reached.
+	Errors []error `json:"errors"`
+}
+
+// GetServiceList returns the services of the member clusters chosen by the options.
+func GetServiceList(ctx context.Context, karmadaClient karmadaclientset.Interface, opts Options,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ServiceList, error) {
+	results, nonCriticalErrors, err := fanOut(ctx, karmadaClient, opts, func(memberClient kubernetes.Interface) ([]service.Service, []error, error) {
+		list, err := service.GetServiceList(memberClient, nsQuery, dataselect.NoDataSelect)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Services, list.Errors, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Service, 0)
+	for _, result := range results {
+		for _, item := range result.items {
+			items = append(items, Service{Service: item, Cluster: result.cluster})
+		}
+	}
+	items, filteredTotal := selectItems(items, func(index int, item Service) ItemCell {
+		return ItemCell{ObjectMeta: item.ObjectMeta, Cluster: item.Cluster, Status: string(item.Type), Index: index}
+	}, dsQuery)
+	return &ServiceList{
+		ListMeta: types.ListMeta{TotalItems: filteredTotal},
+		Items:    items,
+		Errors:   nonCriticalErrors,
+	}, nil
+}
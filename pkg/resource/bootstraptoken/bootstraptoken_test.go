@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstraptoken
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes/fake"
+	certutil "k8s.io/client-go/util/cert"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+func testCACertificate(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := certutil.NewSelfSignedCACert(certutil.Config{CommonName: "karmada"}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pem, err := certutil.EncodeCertificates(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem
+}
+
+func TestBootstrapTokenLifecycle(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	created, err := CreateBootstrapToken(client, &BootstrapTokenSpec{
+		TTL:               time.Hour,
+		Description:       "edge clusters",
+		APIServerEndpoint: "https://karmada.example.com:5443",
+		CACertificates:    testCACertificate(t),
+	})
+	if err != nil {
+		t.Fatalf("CreateBootstrapToken() error = %v", err)
+	}
+	if !tokenIDRegexp.MatchString(created.ID) || !strings.HasPrefix(created.Token, created.ID+".") || len(created.Token) != 23 {
+		t.Fatalf("unexpected token %q with id %q", created.Token, created.ID)
+	}
+	if len(created.CACertHashes) != 1 || !strings.HasPrefix(created.CACertHashes[0], "sha256:") {
+		t.Fatalf("unexpected CA hashes %v", created.CACertHashes)
+	}
+	expectedCommand := "karmadactl register karmada.example.com:5443 --token " + created.Token +
+		" --discovery-token-ca-cert-hash " + created.CACertHashes[0]
+	if created.RegisterCommand != expectedCommand {
+		t.Errorf("RegisterCommand = %q, want %q", created.RegisterCommand, expectedCommand)
+	}
+	if created.Expired || created.Expires == nil || time.Until(created.Expires.Time) <= 0 {
+		t.Errorf("unexpected expiration %v", created.Expires)
+	}
+
+	list, err := GetBootstrapTokenList(client, dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetBootstrapTokenList() error = %v", err)
+	}
+	if len(list.Tokens) != 1 || list.Tokens[0].ID != created.ID || list.Tokens[0].Description != "edge clusters" {
+		t.Fatalf("unexpected token list %+v", list.Tokens)
+	}
+	if strings.Join(list.Tokens[0].Usages, ",") != "authentication,signing" {
+		t.Errorf("unexpected usages %v", list.Tokens[0].Usages)
+	}
+
+	expired, err := ExpireBootstrapToken(client, created.ID)
+	if err != nil {
+		t.Fatalf("ExpireBootstrapToken() error = %v", err)
+	}
+	if !expired.Expired {
+		t.Errorf("expected token to be expired, expires %v", expired.Expires)
+	}
+
+	if err = DeleteBootstrapToken(client, created.ID); err != nil {
+		t.Fatalf("DeleteBootstrapToken() error = %v", err)
+	}
+	if err = DeleteBootstrapToken(client, "../etc"); err == nil {
+		t.Errorf("expected an invalid token id to be rejected")
+	}
+}
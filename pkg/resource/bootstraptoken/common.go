@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstraptoken
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// The layout of a bootstrap token Secret, see
+// https://kubernetes.io/docs/reference/access-authn-authz/bootstrap-tokens/#bootstrap-token-secret-format
+const (
+	secretNamePrefix  = "bootstrap-token-"
+	tokenIDKey        = "token-id"
+	tokenSecretKey    = "token-secret"
+	expirationKey     = "expiration"
+	descriptionKey    = "description"
+	usagePrefix       = "usage-bootstrap-"
+	extraGroupsKey    = "auth-extra-groups"
+	tokenIDLength     = 6
+	tokenSecretLength = 16
+	tokenCharset      = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+var (
+	// DefaultTTL is how long a token is valid unless requested otherwise, the same as `karmadactl token create`.
+	DefaultTTL = 24 * time.Hour
+	// DefaultUsages are the usages of a cluster registration token.
+	DefaultUsages = []string{"signing", "authentication"}
+	// DefaultGroups are the groups a cluster registration token authenticates as, karmada grants them
+	// the permission to create the CSRs of registering agents.
+	DefaultGroups = []string{"system:bootstrappers:karmada:default-cluster-token"}
+)
+
+// SecretName returns the name of the Secret that stores the token with the given id.
+func SecretName(id string) string {
+	return secretNamePrefix + id
+}
+
+// TokenCell wraps a bootstrap token Secret for data selection.
+type TokenCell corev1.Secret
+
+// GetProperty returns a property of the token cell.
+func (c TokenCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.Data[tokenIDKey])
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []corev1.Secret) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = TokenCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []corev1.Secret {
+	std := make([]corev1.Secret, len(cells))
+	for i := range std {
+		std[i] = corev1.Secret(cells[i].(TokenCell))
+	}
+	return std
+}
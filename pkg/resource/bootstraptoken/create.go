@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstraptoken
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/karmada-io/karmada/pkg/util/lifted/pubkeypin"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+var tokenIDRegexp = regexp.MustCompile(`^[a-z0-9]{6}$`)
+
+// BootstrapTokenSpec describes the token to create.
+type BootstrapTokenSpec struct {
+	// TTL defaults to DefaultTTL.
+	TTL         time.Duration
+	Description string
+	// APIServerEndpoint is the karmada-apiserver address the registering agents connect to.
+	APIServerEndpoint string
+	// CACertificates is the CA bundle of the karmada-apiserver in PEM format, its public key hashes
+	// let the agents verify the control plane they register with.
+	CACertificates []byte
+}
+
+// CreatedBootstrapToken is a freshly created token. It is the only time the secret part of the
+// token is returned.
+type CreatedBootstrapToken struct {
+	BootstrapToken `json:",inline"`
+	Token          string   `json:"token"`
+	CACertHashes   []string `json:"caCertHashes"`
+	// RegisterCommand is a ready-made `karmadactl register` command for the member cluster.
+	RegisterCommand string `json:"registerCommand"`
+}
+
+// CreateBootstrapToken generates a random bootstrap token for cluster registration, like
+// `karmadactl token create --print-register-command`.
+func CreateBootstrapToken(client kubernetes.Interface, spec *BootstrapTokenSpec) (*CreatedBootstrapToken, error) {
+	caCerts, err := certutil.ParseCertsPEM(spec.CACertificates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the karmada-apiserver CA certificates: %w", err)
+	}
+	hashes := make([]string, 0, len(caCerts))
+	for _, caCert := range caCerts {
+		hashes = append(hashes, pubkeypin.Hash(caCert))
+	}
+
+	id, err := randomString(tokenIDLength)
+	if err != nil {
+		return nil, err
+	}
+	tokenSecret, err := randomString(tokenSecretLength)
+	if err != nil {
+		return nil, err
+	}
+	ttl := spec.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecretName(id),
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: corev1.SecretTypeBootstrapToken,
+		Data: map[string][]byte{
+			tokenIDKey:     []byte(id),
+			tokenSecretKey: []byte(tokenSecret),
+			expirationKey:  []byte(time.Now().Add(ttl).UTC().Format(time.RFC3339)),
+			extraGroupsKey: []byte(strings.Join(DefaultGroups, ",")),
+		},
+	}
+	if spec.Description != "" {
+		secret.Data[descriptionKey] = []byte(spec.Description)
+	}
+	for _, usage := range DefaultUsages {
+		secret.Data[usagePrefix+usage] = []byte("true")
+	}
+	secret, err = client.CoreV1().Secrets(metav1.NamespaceSystem).Create(context.TODO(), secret, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	token := id + "." + tokenSecret
+	return &CreatedBootstrapToken{
+		BootstrapToken:  toBootstrapToken(secret, time.Now()),
+		Token:           token,
+		CACertHashes:    hashes,
+		RegisterCommand: RegisterCommand(spec.APIServerEndpoint, token, hashes),
+	}, nil
+}
+
+// RegisterCommand returns the `karmadactl register` command that registers a pull mode cluster
+// with the token.
+func RegisterCommand(endpoint, token string, caCertHashes []string) string {
+	return fmt.Sprintf("karmadactl register %s --token %s --discovery-token-ca-cert-hash %s",
+		strings.TrimPrefix(endpoint, "https://"), token, strings.Join(caCertHashes, ","))
+}
+
+// ExpireBootstrapToken makes the token expire now, the agents can no longer authenticate with it
+// and the token cleaner of the control plane removes it eventually.
+func ExpireBootstrapToken(client kubernetes.Interface, id string) (*BootstrapToken, error) {
+	secret, err := getTokenSecret(client, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	secret.Data[expirationKey] = []byte(now.UTC().Format(time.RFC3339))
+	secret, err = client.CoreV1().Secrets(metav1.NamespaceSystem).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	token := toBootstrapToken(secret, now)
+	return &token, nil
+}
+
+// DeleteBootstrapToken revokes the token right away.
+func DeleteBootstrapToken(client kubernetes.Interface, id string) error {
+	if !tokenIDRegexp.MatchString(id) {
+		return invalidTokenID(id)
+	}
+	return client.CoreV1().Secrets(metav1.NamespaceSystem).Delete(context.TODO(), SecretName(id), metav1.DeleteOptions{})
+}
+
+func getTokenSecret(client kubernetes.Interface, id string) (*corev1.Secret, error) {
+	if !tokenIDRegexp.MatchString(id) {
+		return nil, invalidTokenID(id)
+	}
+	secret, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(context.TODO(), SecretName(id), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if secret.Type != corev1.SecretTypeBootstrapToken {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "bootstraptokens"}, id)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	return secret, nil
+}
+
+func invalidTokenID(id string) error {
+	return apierrors.NewBadRequest(fmt.Sprintf("invalid bootstrap token id %q, it must match %s", id, tokenIDRegexp))
+}
+
+func randomString(length int) (string, error) {
+	max := big.NewInt(int64(len(tokenCharset)))
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate a random token: %w", err)
+		}
+		b[i] = tokenCharset[n.Int64()]
+	}
+	return string(b), nil
+}
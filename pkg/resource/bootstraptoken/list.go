@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstraptoken
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// BootstrapToken contains information about a single bootstrap token, without its secret part.
+type BootstrapToken struct {
+	ObjectMeta  types.ObjectMeta `json:"objectMeta"`
+	TypeMeta    types.TypeMeta   `json:"typeMeta"`
+	ID          string           `json:"id"`
+	Description string           `json:"description,omitempty"`
+	// Expires is empty for a token that never expires.
+	Expires *metav1.Time `json:"expires,omitempty"`
+	Expired bool         `json:"expired"`
+	Usages  []string     `json:"usages"`
+	Groups  []string     `json:"groups"`
+}
+
+// BootstrapTokenList contains a list of bootstrap tokens in the karmada control-plane.
+type BootstrapTokenList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of tokens.
+	Tokens []BootstrapToken `json:"tokens"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetBootstrapTokenList returns the bootstrap tokens stored in the kube-system namespace.
+func GetBootstrapTokenList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*BootstrapTokenList, error) {
+	secrets, err := client.CoreV1().Secrets(metav1.NamespaceSystem).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", string(corev1.SecretTypeBootstrapToken)).String(),
+	})
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	if secrets == nil {
+		secrets = &corev1.SecretList{}
+	}
+
+	tokenCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(secrets.Items), dsQuery)
+	result := &BootstrapTokenList{
+		ListMeta: types.ListMeta{TotalItems: filteredTotal},
+		Tokens:   make([]BootstrapToken, 0),
+		Errors:   nonCriticalErrors,
+	}
+	for _, secret := range fromCells(tokenCells) {
+		result.Tokens = append(result.Tokens, toBootstrapToken(&secret, time.Now()))
+	}
+	return result, nil
+}
+
+func toBootstrapToken(secret *corev1.Secret, now time.Time) BootstrapToken {
+	token := BootstrapToken{
+		ObjectMeta:  types.NewObjectMeta(secret.ObjectMeta),
+		TypeMeta:    types.NewTypeMeta(types.ResourceKindBootstrapToken),
+		ID:          string(secret.Data[tokenIDKey]),
+		Description: string(secret.Data[descriptionKey]),
+		Usages:      make([]string, 0),
+		Groups:      make([]string, 0),
+	}
+	if expiration, err := time.Parse(time.RFC3339, string(secret.Data[expirationKey])); err == nil {
+		token.Expires = &metav1.Time{Time: expiration}
+		token.Expired = !now.Before(expiration)
+	}
+	for key, value := range secret.Data {
+		if strings.HasPrefix(key, usagePrefix) && string(value) == "true" {
+			token.Usages = append(token.Usages, strings.TrimPrefix(key, usagePrefix))
+		}
+	}
+	sort.Strings(token.Usages)
+	if groups := string(secret.Data[extraGroupsKey]); groups != "" {
+		token.Groups = strings.Split(groups, ",")
+	}
+	return token
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatesigningrequest
+
+import (
+	"context"
+	"fmt"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ApproveCertificateSigningRequest approves or denies a pending CSR, the same as
+// `kubectl certificate approve|deny`.
+func ApproveCertificateSigningRequest(client kubernetes.Interface, name string, approve bool, message string) (*CertificateSigningRequest, error) {
+	csr, err := client.CertificatesV1().CertificateSigningRequests().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if status := csrStatus(csr); status != StatusPending {
+		return nil, apierrors.NewConflict(certificatesv1.Resource("certificatesigningrequests"), name,
+			fmt.Errorf("the certificate signing request is already %s", status))
+	}
+
+	condition := certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         "KarmadaDashboardApprove",
+		Message:        "This CSR was approved by the karmada dashboard",
+		LastUpdateTime: metav1.Now(),
+	}
+	if !approve {
+		condition.Type = certificatesv1.CertificateDenied
+		condition.Reason = "KarmadaDashboardDeny"
+		condition.Message = "This CSR was denied by the karmada dashboard"
+	}
+	if message != "" {
+		condition.Message = message
+	}
+	csr.Status.Conditions = append(csr.Status.Conditions, condition)
+	csr, err = client.CertificatesV1().CertificateSigningRequests().UpdateApproval(context.TODO(), name, csr, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := toCertificateSigningRequest(csr)
+	return &result, nil
+}
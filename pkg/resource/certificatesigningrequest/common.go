@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatesigningrequest
+
+import (
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// CertificateSigningRequestCell wraps certificatesv1.CertificateSigningRequest for data selection.
+type CertificateSigningRequestCell certificatesv1.CertificateSigningRequest
+
+// GetProperty returns a property of the CSR cell.
+func (c CertificateSigningRequestCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.StatusProperty:
+		csr := certificatesv1.CertificateSigningRequest(c)
+		return dataselect.StdComparableString(csrStatus(&csr))
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []certificatesv1.CertificateSigningRequest) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = CertificateSigningRequestCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []certificatesv1.CertificateSigningRequest {
+	std := make([]certificatesv1.CertificateSigningRequest, len(cells))
+	for i := range std {
+		std[i] = certificatesv1.CertificateSigningRequest(cells[i].(CertificateSigningRequestCell))
+	}
+	return std
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatesigningrequest
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// The states of a CSR, derived from its conditions and issued certificate.
+const (
+	StatusPending  = "Pending"
+	StatusApproved = "Approved"
+	StatusIssued   = "Issued"
+	StatusDenied   = "Denied"
+	StatusFailed   = "Failed"
+)
+
+// agentCommonNamePrefix is the common name prefix of the certificates the karmada-agent requests,
+// e.g. system:karmada:agent:member1.
+const agentCommonNamePrefix = "system:karmada:agent"
+
+// CertificateSigningRequest contains information about a single CSR of a registering agent.
+type CertificateSigningRequest struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	SignerName string           `json:"signerName"`
+	// Username is who created the CSR, a bootstrap token user for a registering agent.
+	Username string   `json:"username"`
+	Groups   []string `json:"groups"`
+	// CommonName and Organizations are the subject the certificate is requested for.
+	CommonName    string   `json:"commonName"`
+	Organizations []string `json:"organizations"`
+	// ClusterName is the cluster the agent registers, when the common name reveals it.
+	ClusterName string `json:"clusterName,omitempty"`
+	Status      string `json:"status"`
+	Message     string `json:"message,omitempty"`
+}
+
+// CertificateSigningRequestList contains the CSRs of registering agents in the karmada control-plane.
+type CertificateSigningRequestList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of CSRs.
+	CertificateSigningRequests []CertificateSigningRequest `json:"certificateSigningRequests"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetCertificateSigningRequestList returns the CSRs for karmada-apiserver client certificates,
+// which is what `karmadactl register` and the karmada-agent request.
+func GetCertificateSigningRequestList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*CertificateSigningRequestList, error) {
+	csrs, err := client.CertificatesV1().CertificateSigningRequests().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	if csrs == nil {
+		csrs = &certificatesv1.CertificateSigningRequestList{}
+	}
+
+	agentCSRs := make([]certificatesv1.CertificateSigningRequest, 0, len(csrs.Items))
+	for _, csr := range csrs.Items {
+		if csr.Spec.SignerName == certificatesv1.KubeAPIServerClientSignerName {
+			agentCSRs = append(agentCSRs, csr)
+		}
+	}
+
+	csrCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(agentCSRs), dsQuery)
+	result := &CertificateSigningRequestList{
+		ListMeta:                   types.ListMeta{TotalItems: filteredTotal},
+		CertificateSigningRequests: make([]CertificateSigningRequest, 0),
+		Errors:                     nonCriticalErrors,
+	}
+	for _, csr := range fromCells(csrCells) {
+		result.CertificateSigningRequests = append(result.CertificateSigningRequests, toCertificateSigningRequest(&csr))
+	}
+	return result, nil
+}
+
+func toCertificateSigningRequest(csr *certificatesv1.CertificateSigningRequest) CertificateSigningRequest {
+	result := CertificateSigningRequest{
+		ObjectMeta:    types.NewObjectMeta(csr.ObjectMeta),
+		TypeMeta:      types.NewTypeMeta(types.ResourceKindCertificateSigningRequest),
+		SignerName:    csr.Spec.SignerName,
+		Username:      csr.Spec.Username,
+		Groups:        csr.Spec.Groups,
+		Organizations: make([]string, 0),
+		Status:        csrStatus(csr),
+	}
+	for _, condition := range csr.Status.Conditions {
+		if condition.Message != "" {
+			result.Message = condition.Message
+		}
+	}
+	if block, _ := pem.Decode(csr.Spec.Request); block != nil {
+		if request, err := x509.ParseCertificateRequest(block.Bytes); err == nil {
+			result.CommonName = request.Subject.CommonName
+			result.Organizations = append(result.Organizations, request.Subject.Organization...)
+		}
+	}
+	if strings.HasPrefix(result.CommonName, agentCommonNamePrefix+":") {
+		result.ClusterName = strings.TrimPrefix(result.CommonName, agentCommonNamePrefix+":")
+	}
+	return result
+}
+
+func csrStatus(csr *certificatesv1.CertificateSigningRequest) string {
+	status := StatusPending
+	for _, condition := range csr.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case certificatesv1.CertificateDenied:
+			return StatusDenied
+		case certificatesv1.CertificateFailed:
+			return StatusFailed
+		case certificatesv1.CertificateApproved:
+			status = StatusApproved
+		}
+	}
+	if status == StatusApproved && len(csr.Status.Certificate) > 0 {
+		return StatusIssued
+	}
+	return status
+}
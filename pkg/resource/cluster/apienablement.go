@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KindSupport tells whether a cluster serves a kind, according to its API enablements.
+type KindSupport struct {
+	Cluster    string `json:"cluster"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Resource is the plural resource name of the kind, empty when the kind is not served.
+	Resource  string `json:"resource,omitempty"`
+	Supported bool   `json:"supported"`
+	// Complete is false when the cluster could not collect all of its APIs, in which case an
+	// unsupported kind may still be served.
+	Complete bool `json:"complete"`
+}
+
+// LookupKind tells whether the cluster serves the kind. When apiVersion is empty any group version
+// serving the kind matches.
+func LookupKind(cluster *v1alpha1.Cluster, apiVersion, kind string) KindSupport {
+	result := KindSupport{
+		Cluster:    cluster.Name,
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Complete:   !meta.IsStatusConditionFalse(cluster.Status.Conditions, v1alpha1.ClusterConditionCompleteAPIEnablements),
+	}
+	for _, enablement := range cluster.Status.APIEnablements {
+		if apiVersion != "" && enablement.GroupVersion != apiVersion {
+			continue
+		}
+		for _, apiResource := range enablement.Resources {
+			if apiResource.Kind == kind {
+				result.APIVersion = enablement.GroupVersion
+				result.Resource = apiResource.Name
+				result.Supported = true
+				return result
+			}
+		}
+	}
+	return result
+}
+
+// GetKindSupport tells whether the named cluster serves the kind.
+func GetKindSupport(client karmadaclientset.Interface, clusterName, apiVersion, kind string) (*KindSupport, error) {
+	cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := LookupKind(cluster, apiVersion, kind)
+	return &result, nil
+}
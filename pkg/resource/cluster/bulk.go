@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// BulkOperationType is the kind of change a bulk operation makes to a label or taint.
+type BulkOperationType string
+
+const (
+	// BulkOperationAdd sets the label, or adds the taint when the cluster has no taint with the same key and effect.
+	BulkOperationAdd BulkOperationType = "add"
+	// BulkOperationUpdate changes the value of the label or taint, clusters without it are left alone.
+	BulkOperationUpdate BulkOperationType = "update"
+	// BulkOperationRemove removes the label, or the taints with the key and, when given, the effect.
+	BulkOperationRemove BulkOperationType = "remove"
+)
+
+// LabelOperation is a change to a cluster label.
+type LabelOperation struct {
+	Op    BulkOperationType `json:"op" binding:"required,oneof=add update remove"`
+	Key   string            `json:"key" binding:"required"`
+	Value string            `json:"value"`
+}
+
+// TaintOperation is a change to a cluster taint.
+type TaintOperation struct {
+	Op     BulkOperationType  `json:"op" binding:"required,oneof=add update remove"`
+	Key    string             `json:"key" binding:"required"`
+	Value  string             `json:"value"`
+	Effect corev1.TaintEffect `json:"effect"`
+}
+
+// BulkClusterResult is the outcome of a bulk update for a single cluster.
+type BulkClusterResult struct {
+	Cluster string `json:"cluster"`
+	// Changed is false when the operations did not change anything on the cluster.
+	Changed bool              `json:"changed"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Taints  []corev1.Taint    `json:"taints,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// BulkUpdateResult is the outcome of a bulk update.
+type BulkUpdateResult struct {
+	DryRun  bool                `json:"dryRun"`
+	Results []BulkClusterResult `json:"results"`
+	// Failed is the number of clusters the operations could not be applied to.
+	Failed int `json:"failed"`
+}
+
+// BulkUpdateClusters applies the label and taint operations to the clusters matching the selector.
+// Each cluster is patched on its own, so a failure does not prevent the other clusters from being
+// updated. With dryRun the patches are only validated by the server.
+func BulkUpdateClusters(ctx context.Context, client karmadaclientset.Interface, selector string,
+	labelOps []LabelOperation, taintOps []TaintOperation, dryRun bool) (*BulkUpdateResult, error) {
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, err
+	}
+	for _, op := range taintOps {
+		if op.Op != BulkOperationRemove && op.Effect == "" {
+			return nil, fmt.Errorf("taint %s: effect is required to %s a taint", op.Key, op.Op)
+		}
+	}
+	clusters, err := client.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BulkUpdateResult{DryRun: dryRun, Results: make([]BulkClusterResult, 0, len(clusters.Items))}
+	for i := range clusters.Items {
+		clusterResult := bulkUpdateCluster(ctx, client, &clusters.Items[i], labelOps, taintOps, dryRun)
+		if clusterResult.Error != "" {
+			result.Failed++
+		}
+		result.Results = append(result.Results, clusterResult)
+	}
+	return result, nil
+}
+
+func bulkUpdateCluster(ctx context.Context, client karmadaclientset.Interface, cluster *v1alpha1.Cluster,
+	labelOps []LabelOperation, taintOps []TaintOperation, dryRun bool) BulkClusterResult {
+	result := BulkClusterResult{Cluster: cluster.Name}
+	first := true
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// the listed cluster is used for the first attempt, a conflict means it is stale
+		if !first {
+			latest, err := client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			cluster = latest
+		}
+		first = false
+
+		newLabels := applyLabelOperations(cluster.Labels, labelOps)
+		newTaints := applyTaintOperations(cluster.Spec.Taints, taintOps)
+		result.Labels, result.Taints = newLabels, newTaints
+		result.Changed = !sameLabels(newLabels, cluster.Labels) || !sameTaints(newTaints, cluster.Spec.Taints)
+		if !result.Changed {
+			return nil
+		}
+
+		// the resourceVersion in the patch makes the server reject it with a conflict when the
+		// cluster changed since it was read
+		patch, err := json.Marshal([]map[string]interface{}{
+			{"op": "add", "path": "/metadata/resourceVersion", "value": cluster.ResourceVersion},
+			{"op": "add", "path": "/metadata/labels", "value": newLabels},
+			{"op": "add", "path": "/spec/taints", "value": newTaints},
+		})
+		if err != nil {
+			return err
+		}
+		options := metav1.PatchOptions{}
+		if dryRun {
+			options.DryRun = []string{metav1.DryRunAll}
+		}
+		_, err = client.ClusterV1alpha1().Clusters().Patch(ctx, cluster.Name, types.JSONPatchType, patch, options)
+		return err
+	})
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func sameLabels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
+
+func sameTaints(a, b []corev1.Taint) bool {
+	return len(a) == len(b) && (len(a) == 0 || apiequality.Semantic.DeepEqual(a, b))
+}
+
+// applyLabelOperations returns the labels after the operations, the given labels are not modified.
+func applyLabelOperations(current map[string]string, ops []LabelOperation) map[string]string {
+	result := make(map[string]string, len(current))
+	for k, v := range current {
+		result[k] = v
+	}
+	for _, op := range ops {
+		switch op.Op {
+		case BulkOperationAdd:
+			result[op.Key] = op.Value
+		case BulkOperationUpdate:
+			if _, ok := result[op.Key]; ok {
+				result[op.Key] = op.Value
+			}
+		case BulkOperationRemove:
+			delete(result, op.Key)
+		}
+	}
+	return result
+}
+
+// applyTaintOperations returns the taints after the operations, the given taints are not modified.
+func applyTaintOperations(current []corev1.Taint, ops []TaintOperation) []corev1.Taint {
+	result := make([]corev1.Taint, 0, len(current))
+	for _, taint := range current {
+		result = append(result, *taint.DeepCopy())
+	}
+	for _, op := range ops {
+		target := corev1.Taint{Key: op.Key, Value: op.Value, Effect: op.Effect}
+		switch op.Op {
+		case BulkOperationAdd:
+			found := false
+			for i := range result {
+				if result[i].MatchTaint(&target) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				if target.Effect == corev1.TaintEffectNoExecute {
+					now := metav1.Now()
+					target.TimeAdded = &now
+				}
+				result = append(result, target)
+			}
+		case BulkOperationUpdate:
+			for i := range result {
+				if result[i].MatchTaint(&target) {
+					result[i].Value = op.Value
+				}
+			}
+		case BulkOperationRemove:
+			kept := result[:0]
+			for _, taint := range result {
+				if taint.Key == op.Key && (op.Effect == "" || taint.Effect == op.Effect) {
+					continue
+				}
+				kept = append(kept, taint)
+			}
+			result = kept
+		}
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestApplyLabelOperations(t *testing.T) {
+	current := map[string]string{"region": "eu", "tier": "silver"}
+	result := applyLabelOperations(current, []LabelOperation{
+		{Op: BulkOperationAdd, Key: "env", Value: "prod"},
+		{Op: BulkOperationUpdate, Key: "tier", Value: "gold"},
+		{Op: BulkOperationUpdate, Key: "missing", Value: "x"},
+		{Op: BulkOperationRemove, Key: "region"},
+	})
+	expected := map[string]string{"env": "prod", "tier": "gold"}
+	if !sameLabels(result, expected) {
+		t.Fatalf("expected %v, got %v", expected, result)
+	}
+	if current["tier"] != "silver" || len(current) != 2 {
+		t.Fatalf("expected the current labels to be left untouched, got %v", current)
+	}
+}
+
+func TestApplyTaintOperations(t *testing.T) {
+	current := []corev1.Taint{
+		{Key: "maintenance", Value: "a", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "maintenance", Effect: corev1.TaintEffectNoExecute},
+		{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+	}
+	result := applyTaintOperations(current, []TaintOperation{
+		{Op: BulkOperationRemove, Key: "maintenance"},
+		{Op: BulkOperationUpdate, Key: "gpu", Value: "false", Effect: corev1.TaintEffectNoSchedule},
+		{Op: BulkOperationAdd, Key: "gpu", Value: "ignored", Effect: corev1.TaintEffectNoSchedule},
+		{Op: BulkOperationAdd, Key: "dedicated", Value: "ml", Effect: corev1.TaintEffectNoExecute},
+	})
+	if len(result) != 2 {
+		t.Fatalf("expected 2 taints, got %+v", result)
+	}
+	if result[0].Key != "gpu" || result[0].Value != "false" {
+		t.Fatalf("expected the gpu taint to be updated, got %+v", result[0])
+	}
+	if result[1].Key != "dedicated" || result[1].TimeAdded == nil {
+		t.Fatalf("expected a NoExecute dedicated taint with its time added, got %+v", result[1])
+	}
+	if len(current) != 3 || current[2].Value != "true" {
+		t.Fatalf("expected the current taints to be left untouched, got %+v", current)
+	}
+}
@@ -19,6 +19,9 @@ package cluster
 import (
 	"context"
 	"log"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
@@ -45,6 +48,29 @@ type ClusterAllocatedResources struct {
 
 	// PodFraction is a fraction of pods, that can be allocated on given node.
 	PodFraction float64 `json:"podFraction"`
+
+	// EphemeralStorageCapacity is specified node ephemeral storage capacity in bytes.
+	EphemeralStorageCapacity int64   `json:"ephemeralStorageCapacity"`
+	EphemeralStorageFraction float64 `json:"ephemeralStorageFraction"`
+
+	// ExtendedResources are the allocatable extended resources of the cluster, e.g. GPUs.
+	ExtendedResources []ExtendedResource `json:"extendedResources,omitempty"`
+}
+
+// ExtendedResource is the summary of an extended resource of a cluster.
+type ExtendedResource struct {
+	Name      corev1.ResourceName `json:"name"`
+	Capacity  int64               `json:"capacity"`
+	Allocated int64               `json:"allocated"`
+	Fraction  float64             `json:"fraction"`
+}
+
+// isExtendedResourceName tells whether the resource is an extended resource, i.e. a resource outside
+// of the kubernetes.io domain such as nvidia.com/gpu.
+func isExtendedResourceName(name corev1.ResourceName) bool {
+	return strings.Contains(string(name), "/") &&
+		!strings.Contains(string(name), corev1.ResourceDefaultNamespacePrefix) &&
+		!strings.HasPrefix(string(name), corev1.DefaultResourceRequestsPrefix)
 }
 
 func getclusterAllocatedResources(cluster *v1alpha1.Cluster) (ClusterAllocatedResources, error) {
@@ -67,6 +93,34 @@ func getclusterAllocatedResources(cluster *v1alpha1.Cluster) (ClusterAllocatedRe
 		memoryFraction = float64(allocatedMemory.ScaledValue(resource.Micro)) / float64(allocatableMemory.ScaledValue(resource.Micro)) * 100
 	}
 
+	allocatableStorage := cluster.Status.ResourceSummary.Allocatable.StorageEphemeral()
+	allocatedStorage := cluster.Status.ResourceSummary.Allocated.StorageEphemeral()
+	var storageCapacity = allocatableStorage.Value()
+	var storageFraction float64
+	if storageCapacity > 0 {
+		storageFraction = float64(allocatedStorage.Value()) / float64(storageCapacity) * 100
+	}
+
+	extendedResources := make([]ExtendedResource, 0)
+	for name, allocatable := range cluster.Status.ResourceSummary.Allocatable {
+		if !isExtendedResourceName(name) {
+			continue
+		}
+		allocated := cluster.Status.ResourceSummary.Allocated[name]
+		extended := ExtendedResource{
+			Name:      name,
+			Capacity:  allocatable.Value(),
+			Allocated: allocated.Value(),
+		}
+		if extended.Capacity > 0 {
+			extended.Fraction = float64(extended.Allocated) / float64(extended.Capacity) * 100
+		}
+		extendedResources = append(extendedResources, extended)
+	}
+	sort.Slice(extendedResources, func(i, j int) bool {
+		return extendedResources[i].Name < extendedResources[j].Name
+	})
+
 	allocatablePod := cluster.Status.ResourceSummary.Allocatable.Pods()
 	allocatedPod := cluster.Status.ResourceSummary.Allocated.Pods()
 
@@ -76,20 +130,79 @@ func getclusterAllocatedResources(cluster *v1alpha1.Cluster) (ClusterAllocatedRe
 		podFraction = float64(allocatedPod.Value()) / float64(podCapacity) * 100
 	}
 	return ClusterAllocatedResources{
-		CPUCapacity:    allocatableCPU.Value(),
-		CPUFraction:    cpuFraction,
-		MemoryCapacity: allocatedMemory.Value(),
-		MemoryFraction: memoryFraction,
-		AllocatedPods:  allocatedPod.Value(),
-		PodCapacity:    podCapacity,
-		PodFraction:    podFraction,
+		CPUCapacity:              allocatableCPU.Value(),
+		CPUFraction:              cpuFraction,
+		MemoryCapacity:           memoryCapacity,
+		MemoryFraction:           memoryFraction,
+		AllocatedPods:            allocatedPod.Value(),
+		PodCapacity:              podCapacity,
+		PodFraction:              podFraction,
+		EphemeralStorageCapacity: storageCapacity,
+		EphemeralStorageFraction: storageFraction,
+		ExtendedResources:        extendedResources,
 	}, nil
 }
 
 // ClusterDetail is the detailed information of a cluster.
 type ClusterDetail struct {
-	Cluster `json:",inline"`
-	Taints  []corev1.Taint `json:"taints,omitempty"`
+	Cluster     `json:",inline"`
+	Taints      []corev1.Taint `json:"taints,omitempty"`
+	ID          string         `json:"id,omitempty"`
+	APIEndpoint string         `json:"apiEndpoint,omitempty"`
+	ProxyURL    string         `json:"proxyURL,omitempty"`
+	Provider    string         `json:"provider,omitempty"`
+	Region      string         `json:"region,omitempty"`
+	Zones       []string       `json:"zones,omitempty"`
+
+	Conditions     []metav1.Condition       `json:"conditions,omitempty"`
+	APIEnablements []v1alpha1.APIEnablement `json:"apiEnablements,omitempty"`
+	ResourceModels []v1alpha1.ResourceModel `json:"resourceModels,omitempty"`
+	// AllocatableModelings is the number of nodes per resource model grade.
+	AllocatableModelings []v1alpha1.AllocatableModeling `json:"allocatableModelings,omitempty"`
+	// RemainingResources is what is left for scheduling: allocatable minus allocated and allocating.
+	RemainingResources corev1.ResourceList `json:"remainingResources,omitempty"`
+}
+
+// clusterZones returns the zones of the cluster, falling back to the deprecated single zone.
+func clusterZones(cluster *v1alpha1.Cluster) []string {
+	if len(cluster.Spec.Zones) > 0 {
+		return cluster.Spec.Zones
+	}
+	if cluster.Spec.Zone != "" {
+		return []string{cluster.Spec.Zone}
+	}
+	return nil
+}
+
+// redactProxyURL hides the password of a proxy URL with credentials.
+func redactProxyURL(proxyURL string) string {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return proxyURL
+	}
+	return parsed.Redacted()
+}
+
+// remainingResources returns the resources of the cluster that are still available for scheduling.
+func remainingResources(summary *v1alpha1.ResourceSummary) corev1.ResourceList {
+	if summary == nil {
+		return nil
+	}
+	remaining := corev1.ResourceList{}
+	for name, allocatable := range summary.Allocatable {
+		quantity := allocatable.DeepCopy()
+		if allocated, ok := summary.Allocated[name]; ok {
+			quantity.Sub(allocated)
+		}
+		if allocating, ok := summary.Allocating[name]; ok {
+			quantity.Sub(allocating)
+		}
+		if quantity.Sign() < 0 {
+			quantity = *resource.NewQuantity(0, quantity.Format)
+		}
+		remaining[name] = quantity
+	}
+	return remaining
 }
 
 // GetClusterDetail gets details of cluster.
@@ -99,8 +212,22 @@ func GetClusterDetail(client karmadaclientset.Interface, clusterName string) (*C
 	if err != nil {
 		return nil, err
 	}
-	return &ClusterDetail{
-		Cluster: toCluster(cluster),
-		Taints:  cluster.Spec.Taints,
-	}, nil
+	detail := &ClusterDetail{
+		Cluster:            toCluster(cluster),
+		Taints:             cluster.Spec.Taints,
+		ID:                 cluster.Spec.ID,
+		APIEndpoint:        cluster.Spec.APIEndpoint,
+		ProxyURL:           redactProxyURL(cluster.Spec.ProxyURL),
+		Provider:           cluster.Spec.Provider,
+		Region:             cluster.Spec.Region,
+		Zones:              clusterZones(cluster),
+		Conditions:         cluster.Status.Conditions,
+		APIEnablements:     cluster.Status.APIEnablements,
+		ResourceModels:     cluster.Spec.ResourceModels,
+		RemainingResources: remainingResources(cluster.Status.ResourceSummary),
+	}
+	if cluster.Status.ResourceSummary != nil {
+		detail.AllocatableModelings = cluster.Status.ResourceSummary.AllocatableModelings
+	}
+	return detail, nil
 }
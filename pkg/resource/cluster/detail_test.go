@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetClusterAllocatedResources(t *testing.T) {
+	cluster := &v1alpha1.Cluster{
+		Status: v1alpha1.ClusterStatus{
+			ResourceSummary: &v1alpha1.ResourceSummary{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceMemory:           resource.MustParse("8Gi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+					"nvidia.com/gpu":                resource.MustParse("4"),
+					"hugepages-2Mi":                 resource.MustParse("1Gi"),
+				},
+				Allocated: corev1.ResourceList{
+					corev1.ResourceMemory:           resource.MustParse("2Gi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("25Gi"),
+					"nvidia.com/gpu":                resource.MustParse("1"),
+				},
+			},
+		},
+	}
+	allocated, err := getclusterAllocatedResources(cluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if allocated.MemoryCapacity != 8<<30 || allocated.MemoryFraction != 25 {
+		t.Fatalf("expected 8Gi memory capacity at 25%%, got %d at %v", allocated.MemoryCapacity, allocated.MemoryFraction)
+	}
+	if allocated.EphemeralStorageCapacity != 100<<30 || allocated.EphemeralStorageFraction != 25 {
+		t.Fatalf("unexpected ephemeral storage: %d at %v", allocated.EphemeralStorageCapacity, allocated.EphemeralStorageFraction)
+	}
+	if len(allocated.ExtendedResources) != 1 {
+		t.Fatalf("expected only the GPUs as extended resources, got %+v", allocated.ExtendedResources)
+	}
+	if gpu := allocated.ExtendedResources[0]; gpu.Name != "nvidia.com/gpu" || gpu.Capacity != 4 || gpu.Allocated != 1 || gpu.Fraction != 25 {
+		t.Fatalf("unexpected GPU summary: %+v", gpu)
+	}
+}
+
+func TestRemainingResources(t *testing.T) {
+	remaining := remainingResources(&v1alpha1.ResourceSummary{
+		Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")},
+		Allocated:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m"), corev1.ResourcePods: resource.MustParse("8")},
+		Allocating:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourcePods: resource.MustParse("4")},
+	})
+	if cpu := remaining[corev1.ResourceCPU]; cpu.Cmp(resource.MustParse("2")) != 0 {
+		t.Fatalf("expected 2 remaining CPUs, got %s", cpu.String())
+	}
+	if pods := remaining[corev1.ResourcePods]; !pods.IsZero() {
+		t.Fatalf("expected no remaining pods, got %s", pods.String())
+	}
+}
+
+func TestLookupKind(t *testing.T) {
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Status: v1alpha1.ClusterStatus{
+			APIEnablements: []v1alpha1.APIEnablement{
+				{GroupVersion: "apps/v1", Resources: []v1alpha1.APIResource{{Name: "deployments", Kind: "Deployment"}}},
+			},
+			Conditions: []metav1.Condition{
+				{Type: v1alpha1.ClusterConditionCompleteAPIEnablements, Status: metav1.ConditionFalse},
+			},
+		},
+	}
+	if result := LookupKind(cluster, "", "Deployment"); !result.Supported || result.APIVersion != "apps/v1" || result.Resource != "deployments" {
+		t.Fatalf("expected Deployment to be served by apps/v1, got %+v", result)
+	}
+	if result := LookupKind(cluster, "apps/v1beta1", "Deployment"); result.Supported {
+		t.Fatalf("expected apps/v1beta1 Deployment not to be served, got %+v", result)
+	}
+	if result := LookupKind(cluster, "", "CronJob"); result.Supported || result.Complete {
+		t.Fatalf("expected CronJob not to be served with incomplete enablements, got %+v", result)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourcebinding
+
+import (
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// ClusterResourceBindingCell is a wrapper around ClusterResourceBinding type
+type ClusterResourceBindingCell workv1alpha2.ClusterResourceBinding
+
+// GetProperty returns the given property of the ClusterResourceBinding.
+func (c ClusterResourceBindingCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableStringSet(common.BindingClusterNames(&c.Spec))
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableStringSet(common.ConditionStatuses(c.Status.Conditions))
+	case dataselect.PolicyProperty:
+		return dataselect.StdComparableString(common.BindingPolicy(c.ObjectMeta.Annotations))
+	case dataselect.ExecutionNamespaceProperty:
+		return dataselect.StdComparableStringSet(common.BindingExecutionNamespaces(&c.Spec))
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []workv1alpha2.ClusterResourceBinding) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ClusterResourceBindingCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []workv1alpha2.ClusterResourceBinding {
+	std := make([]workv1alpha2.ClusterResourceBinding, len(cells))
+	for i := range std {
+		std[i] = workv1alpha2.ClusterResourceBinding(cells[i].(ClusterResourceBindingCell))
+	}
+	return std
+}
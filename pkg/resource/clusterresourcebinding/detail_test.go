@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourcebinding
+
+import (
+	"errors"
+	"testing"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestGetClusterResourceBindingDetail(t *testing.T) {
+	binding := &workv1alpha2.ClusterResourceBinding{ObjectMeta: metav1.ObjectMeta{
+		Name:   "admin-clusterrole",
+		Labels: map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "binding-id"},
+	}}
+	bindingWork := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "karmada-es-member1",
+		Name:      "admin-6d9c5f8b7c",
+		Labels:    map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "binding-id"},
+	}}
+	otherWork := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "karmada-es-member2",
+		Name:      "redis-5c8b7f9d4b",
+		Labels:    map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "another-binding-id"},
+	}}
+
+	tests := []struct {
+		name       string
+		listErr    error
+		wantWorks  []string
+		wantErrors int
+	}{
+		{
+			name:      "works of the binding are joined",
+			wantWorks: []string{"admin-6d9c5f8b7c"},
+		},
+		{
+			name:       "failing work list is a non-critical error",
+			listErr:    errors.New("etcd timeout"),
+			wantErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := karmadafake.NewSimpleClientset(binding, bindingWork, otherWork)
+			if tt.listErr != nil {
+				client.PrependReactor("list", "works", func(clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.listErr
+				})
+			}
+
+			detail, err := GetClusterResourceBindingDetail(client, "admin-clusterrole")
+			if err != nil {
+				t.Fatalf("GetClusterResourceBindingDetail() error = %v", err)
+			}
+			if len(detail.Errors) != tt.wantErrors {
+				t.Errorf("expected %d errors, got %v", tt.wantErrors, detail.Errors)
+			}
+			if detail.Works == nil || len(detail.Works) != len(tt.wantWorks) {
+				t.Fatalf("expected works %v, got %+v", tt.wantWorks, detail.Works)
+			}
+			for i, name := range tt.wantWorks {
+				if detail.Works[i].ObjectMeta.Name != name {
+					t.Errorf("expected work %s, got %s", name, detail.Works[i].ObjectMeta.Name)
+				}
+			}
+		})
+	}
+}
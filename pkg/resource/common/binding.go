@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindingClusterNames returns the clusters a ResourceBinding or ClusterResourceBinding is scheduled to.
+func BindingClusterNames(spec *workv1alpha2.ResourceBindingSpec) []string {
+	clusters := make([]string, 0, len(spec.Clusters))
+	for _, target := range spec.Clusters {
+		clusters = append(clusters, target.Name)
+	}
+	return clusters
+}
+
+// BindingExecutionNamespaces returns the execution namespaces the Works of a binding are created in.
+func BindingExecutionNamespaces(spec *workv1alpha2.ResourceBindingSpec) []string {
+	namespaces := make([]string, 0, len(spec.Clusters))
+	for _, target := range spec.Clusters {
+		namespaces = append(namespaces, names.GenerateExecutionSpaceName(target.Name))
+	}
+	return namespaces
+}
+
+// BindingPolicy returns the policy a binding was created from, "namespace/name" for a
+// PropagationPolicy and "name" for a ClusterPropagationPolicy.
+func BindingPolicy(annotations map[string]string) string {
+	if name := annotations[policyv1alpha1.PropagationPolicyNameAnnotation]; name != "" {
+		return fmt.Sprintf("%s/%s", annotations[policyv1alpha1.PropagationPolicyNamespaceAnnotation], name)
+	}
+	return annotations[policyv1alpha1.ClusterPropagationPolicyAnnotation]
+}
+
+// ConditionStatuses renders conditions as "Type=Status", e.g. "Scheduled=False", for filtering.
+func ConditionStatuses(conditions []metav1.Condition) []string {
+	statuses := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		statuses = append(statuses, fmt.Sprintf("%s=%s", condition.Type, condition.Status))
+	}
+	return statuses
+}
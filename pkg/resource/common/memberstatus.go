@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"sort"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	api "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/karmada-io/dashboard/pkg/informer"
+)
+
+// MemberStatus is the status of a resource template in a single member cluster, as collected into
+// its ResourceBinding by the status interpreter.
+type MemberStatus struct {
+	Cluster string `json:"cluster"`
+	// Applied is false when the manifest could not be applied to the cluster, Message tells why.
+	Applied bool   `json:"applied"`
+	Message string `json:"message,omitempty"`
+	// Health is Healthy, Unhealthy or Unknown.
+	Health string `json:"health"`
+	// DesiredReplicas are the replicas scheduled to the cluster, or the pods a DaemonSet should run.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	ReadyReplicas   int32 `json:"readyReplicas"`
+	// Conditions are the conditions of the member object, for the kinds that report them.
+	Conditions []Condition `json:"conditions"`
+	// LastUpdateTime is the last time the member object or its Work changed condition, nil if unknown.
+	LastUpdateTime *v1.Time `json:"lastUpdateTime"`
+}
+
+// ClusterSummary counts the member clusters of a resource template, e.g. for a list column.
+type ClusterSummary struct {
+	Scheduled int `json:"scheduled"`
+	Healthy   int `json:"healthy"`
+}
+
+// memberObjectStatus holds the status fields the status interpreter reflects for the workload kinds.
+type memberObjectStatus struct {
+	Replicas               *int32 `json:"replicas"`
+	ReadyReplicas          int32  `json:"readyReplicas"`
+	DesiredNumberScheduled *int32 `json:"desiredNumberScheduled"`
+	NumberReady            int32  `json:"numberReady"`
+	Ready                  *int32 `json:"ready"`
+	Conditions             []struct {
+		Type               string              `json:"type"`
+		Status             api.ConditionStatus `json:"status"`
+		LastProbeTime      v1.Time             `json:"lastProbeTime"`
+		LastUpdateTime     v1.Time             `json:"lastUpdateTime"`
+		LastTransitionTime v1.Time             `json:"lastTransitionTime"`
+		Reason             string              `json:"reason"`
+		Message            string              `json:"message"`
+	} `json:"conditions"`
+}
+
+// bindingsByOwner returns the ResourceBindings of the resource template with the uid.
+func bindingsByOwner(uid types.UID) []*workv1alpha2.ResourceBinding {
+	if !informer.Started() {
+		return nil
+	}
+	items, err := informer.ResourceBindingIndexer().ByIndex(informer.ResourceBindingByOwnerUID, string(uid))
+	if err != nil {
+		return nil
+	}
+	bindings := make([]*workv1alpha2.ResourceBinding, 0, len(items))
+	for _, item := range items {
+		bindings = append(bindings, item.(*workv1alpha2.ResourceBinding))
+	}
+	return bindings
+}
+
+// workUpdateTimes returns the latest condition change of the Works of a binding, by cluster.
+func workUpdateTimes(bindingName string) map[string]v1.Time {
+	times := make(map[string]v1.Time)
+	items, err := informer.WorkIndexer().ByIndex(informer.WorkByRBName, bindingName)
+	if err != nil {
+		return times
+	}
+	for _, item := range items {
+		work := item.(*workv1alpha1.Work)
+		cluster, err := names.GetClusterName(work.Namespace)
+		if err != nil {
+			continue
+		}
+		for _, condition := range work.Status.Conditions {
+			if latest, ok := times[cluster]; !ok || latest.Before(&condition.LastTransitionTime) {
+				times[cluster] = condition.LastTransitionTime
+			}
+		}
+	}
+	return times
+}
+
+// GetMemberStatuses returns the status of the resource template with the uid in every member
+// cluster it is scheduled to, ordered by cluster name.
+func GetMemberStatuses(uid types.UID) []MemberStatus {
+	statuses := make([]MemberStatus, 0)
+	for _, binding := range bindingsByOwner(uid) {
+		statuses = append(statuses, ToMemberStatuses(binding, workUpdateTimes(binding.Name))...)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Cluster < statuses[j].Cluster
+	})
+	return statuses
+}
+
+// GetClusterSummary counts the clusters the resource template with the uid is scheduled to and
+// the ones it is healthy in.
+func GetClusterSummary(uid types.UID) *ClusterSummary {
+	summary := &ClusterSummary{}
+	for _, binding := range bindingsByOwner(uid) {
+		summary.Scheduled += len(binding.Spec.Clusters)
+		for _, item := range binding.Status.AggregatedStatus {
+			if item.Health == workv1alpha2.ResourceHealthy {
+				summary.Healthy++
+			}
+		}
+	}
+	return summary
+}
+
+// ToMemberStatuses returns the per-cluster status of a binding, updateTimes are the latest Work
+// condition changes by cluster.
+func ToMemberStatuses(binding *workv1alpha2.ResourceBinding, updateTimes map[string]v1.Time) []MemberStatus {
+	items := make(map[string]workv1alpha2.AggregatedStatusItem, len(binding.Status.AggregatedStatus))
+	for _, item := range binding.Status.AggregatedStatus {
+		items[item.ClusterName] = item
+	}
+	statuses := make([]MemberStatus, 0, len(binding.Spec.Clusters))
+	for _, target := range binding.Spec.Clusters {
+		status := MemberStatus{
+			Cluster:         target.Name,
+			Health:          string(workv1alpha2.ResourceUnknown),
+			DesiredReplicas: target.Replicas,
+			Conditions:      make([]Condition, 0),
+		}
+		var latest v1.Time
+		if t, ok := updateTimes[target.Name]; ok {
+			latest = t
+		}
+		if item, ok := items[target.Name]; ok {
+			status.Applied = item.Applied
+			status.Message = item.AppliedMessage
+			if item.Health != "" {
+				status.Health = string(item.Health)
+			}
+			if item.Status != nil {
+				objectStatus := memberObjectStatus{}
+				if err := json.Unmarshal(item.Status.Raw, &objectStatus); err == nil {
+					applyObjectStatus(&status, &objectStatus, &latest)
+				}
+			}
+		}
+		if !latest.IsZero() {
+			status.LastUpdateTime = &latest
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func applyObjectStatus(status *MemberStatus, objectStatus *memberObjectStatus, latest *v1.Time) {
+	switch {
+	case objectStatus.DesiredNumberScheduled != nil:
+		status.DesiredReplicas = *objectStatus.DesiredNumberScheduled
+		status.ReadyReplicas = objectStatus.NumberReady
+	case objectStatus.Ready != nil:
+		status.ReadyReplicas = *objectStatus.Ready
+	default:
+		if status.DesiredReplicas == 0 && objectStatus.Replicas != nil {
+			status.DesiredReplicas = *objectStatus.Replicas
+		}
+		status.ReadyReplicas = objectStatus.ReadyReplicas
+	}
+	for _, condition := range objectStatus.Conditions {
+		status.Conditions = append(status.Conditions, Condition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			LastProbeTime:      condition.LastProbeTime,
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+		for _, t := range []v1.Time{condition.LastUpdateTime, condition.LastTransitionTime} {
+			if latest.Before(&t) {
+				*latest = t
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestToMemberStatuses(t *testing.T) {
+	workTime := v1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	binding := &workv1alpha2.ResourceBinding{
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 1}, {Name: "member3"}},
+		},
+		Status: workv1alpha2.ResourceBindingStatus{
+			AggregatedStatus: []workv1alpha2.AggregatedStatusItem{
+				{
+					ClusterName: "member1",
+					Applied:     true,
+					Health:      workv1alpha2.ResourceHealthy,
+					Status: &runtime.RawExtension{Raw: []byte(`{"replicas":2,"readyReplicas":2,"conditions":[
+						{"type":"Available","status":"True","lastUpdateTime":"2026-01-02T00:00:00Z"}]}`)},
+				},
+				{
+					ClusterName:    "member2",
+					AppliedMessage: "admission denied",
+					Health:         workv1alpha2.ResourceUnknown,
+				},
+				{
+					ClusterName: "member3",
+					Applied:     true,
+					Health:      workv1alpha2.ResourceUnhealthy,
+					Status:      &runtime.RawExtension{Raw: []byte(`{"desiredNumberScheduled":3,"numberReady":1}`)},
+				},
+			},
+		},
+	}
+
+	statuses := ToMemberStatuses(binding, map[string]v1.Time{"member1": workTime, "member2": workTime})
+	if len(statuses) != 3 {
+		t.Fatalf("expected 3 statuses, got %d", len(statuses))
+	}
+	member1 := statuses[0]
+	if !member1.Applied || member1.Health != "Healthy" || member1.DesiredReplicas != 2 || member1.ReadyReplicas != 2 ||
+		len(member1.Conditions) != 1 || member1.LastUpdateTime.Day() != 2 {
+		t.Errorf("unexpected status of member1 %+v", member1)
+	}
+	member2 := statuses[1]
+	if member2.Applied || member2.Message != "admission denied" || member2.DesiredReplicas != 1 || !member2.LastUpdateTime.Equal(&workTime) {
+		t.Errorf("unexpected status of member2 %+v", member2)
+	}
+	member3 := statuses[2]
+	if member3.DesiredReplicas != 3 || member3.ReadyReplicas != 1 || member3.LastUpdateTime != nil {
+		t.Errorf("unexpected status of member3 %+v", member3)
+	}
+}
+
+func TestGetClusterSummaryWithoutInformer(t *testing.T) {
+	if summary := GetClusterSummary("uid"); summary.Scheduled != 0 || summary.Healthy != 0 {
+		t.Errorf("expected an empty summary, got %+v", summary)
+	}
+	if statuses := GetMemberStatuses("uid"); len(statuses) != 0 {
+		t.Errorf("expected no statuses, got %+v", statuses)
+	}
+}
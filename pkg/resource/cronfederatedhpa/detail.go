@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/federatedhpa"
+)
+
+// Rule is a rule of a CronFederatedHPA together with its execution history.
+type Rule struct {
+	v1alpha1.CronFederatedHPARule `json:",inline"`
+
+	NextExecutionTime    *metaV1.Time                   `json:"nextExecutionTime"`
+	SuccessfulExecutions []v1alpha1.SuccessfulExecution `json:"successfulExecutions"`
+	FailedExecutions     []v1alpha1.FailedExecution     `json:"failedExecutions"`
+}
+
+// CronFederatedHPADetail is a presentation layer view of a Karmada CronFederatedHPA.
+type CronFederatedHPADetail struct {
+	// Extends list item structure.
+	CronFederatedHPA `json:",inline"`
+
+	RuleDetails []Rule `json:"ruleDetails"`
+	// FederatedHPA is the scaled FederatedHPA, when the CronFederatedHPA scales one instead of a
+	// workload. The topology path of the scale target then leads to the workload it scales.
+	FederatedHPA *federatedhpa.FederatedHPA `json:"federatedHPA,omitempty"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetCronFederatedHPADetail gets CronFederatedHPA details.
+func GetCronFederatedHPADetail(client karmadaclientset.Interface, namespace, name string) (*CronFederatedHPADetail, error) {
+	hpa, err := client.AutoscalingV1alpha1().CronFederatedHPAs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	detail := &CronFederatedHPADetail{
+		CronFederatedHPA: toCronFederatedHPA(hpa),
+		RuleDetails:      toRules(hpa),
+		Errors:           []error{},
+	}
+
+	if hpa.Spec.ScaleTargetRef.Kind == "FederatedHPA" {
+		target, err := federatedhpa.GetFederatedHPADetail(client, namespace, hpa.Spec.ScaleTargetRef.Name)
+		nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+		if criticalError != nil {
+			return nil, criticalError
+		}
+		detail.Errors = append(detail.Errors, nonCriticalErrors...)
+		if target != nil {
+			detail.FederatedHPA = &target.FederatedHPA
+			detail.ScaleTarget.TopologyPath = target.ScaleTarget.TopologyPath
+		}
+	}
+	return detail, nil
+}
+
+// toRules joins the rules with their execution histories.
+func toRules(hpa *v1alpha1.CronFederatedHPA) []Rule {
+	histories := make(map[string]v1alpha1.ExecutionHistory, len(hpa.Status.ExecutionHistories))
+	for _, history := range hpa.Status.ExecutionHistories {
+		histories[history.RuleName] = history
+	}
+	rules := make([]Rule, 0, len(hpa.Spec.Rules))
+	for _, rule := range hpa.Spec.Rules {
+		history := histories[rule.Name]
+		rules = append(rules, Rule{
+			CronFederatedHPARule: rule,
+			NextExecutionTime:    history.NextExecutionTime,
+			SuccessfulExecutions: append([]v1alpha1.SuccessfulExecution{}, history.SuccessfulExecutions...),
+			FailedExecutions:     append([]v1alpha1.FailedExecution{}, history.FailedExecutions...),
+		})
+	}
+	return rules
+}
+
+// CreateCronFederatedHPA creates a CronFederatedHPA from its YAML content in the namespace.
+func CreateCronFederatedHPA(ctx context.Context, client karmadaclientset.Interface, namespace, content string) (*v1alpha1.CronFederatedHPA, error) {
+	hpa := &v1alpha1.CronFederatedHPA{}
+	if err := yaml.UnmarshalStrict([]byte(content), hpa); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid CronFederatedHPA: %v", err))
+	}
+	if hpa.Namespace != "" && hpa.Namespace != namespace {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("CronFederatedHPA namespace %s does not match namespace %s", hpa.Namespace, namespace))
+	}
+	hpa.Namespace = namespace
+	return client.AutoscalingV1alpha1().CronFederatedHPAs(namespace).Create(ctx, hpa, metaV1.CreateOptions{})
+}
+
+// UpdateCronFederatedHPA replaces the spec of a CronFederatedHPA with the one of its YAML content,
+// the metadata and status are kept.
+func UpdateCronFederatedHPA(ctx context.Context, client karmadaclientset.Interface, namespace, name, content string) (*v1alpha1.CronFederatedHPA, error) {
+	hpa := &v1alpha1.CronFederatedHPA{}
+	if err := yaml.UnmarshalStrict([]byte(content), hpa); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid CronFederatedHPA: %v", err))
+	}
+	current, err := client.AutoscalingV1alpha1().CronFederatedHPAs(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	current.Spec = hpa.Spec
+	return client.AutoscalingV1alpha1().CronFederatedHPAs(namespace).Update(ctx, current, metaV1.UpdateOptions{})
+}
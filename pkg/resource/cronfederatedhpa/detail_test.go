@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetCronFederatedHPADetail(t *testing.T) {
+	next := metaV1.Now()
+	fhpa := &v1alpha1.FederatedHPA{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx-hpa"},
+		Spec: v1alpha1.FederatedHPASpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"},
+			MaxReplicas:    10,
+		},
+	}
+	cron := &v1alpha1.CronFederatedHPA{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx-cron"},
+		Spec: v1alpha1.CronFederatedHPASpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "autoscaling.karmada.io/v1alpha1", Kind: "FederatedHPA", Name: "nginx-hpa",
+			},
+			Rules: []v1alpha1.CronFederatedHPARule{
+				{Name: "scale-up", Schedule: "0 8 * * *", TargetMaxReplicas: ptr.To[int32](20)},
+				{Name: "scale-down", Schedule: "0 20 * * *", TargetMaxReplicas: ptr.To[int32](10), Suspend: ptr.To(true)},
+			},
+		},
+		Status: v1alpha1.CronFederatedHPAStatus{
+			ExecutionHistories: []v1alpha1.ExecutionHistory{{
+				RuleName:          "scale-up",
+				NextExecutionTime: &next,
+				SuccessfulExecutions: []v1alpha1.SuccessfulExecution{{
+					ScheduleTime: &next, ExecutionTime: &next, AppliedMaxReplicas: ptr.To[int32](20),
+				}},
+			}},
+		},
+	}
+	client := karmadafake.NewSimpleClientset(fhpa, cron)
+
+	detail, err := GetCronFederatedHPADetail(client, "default", "nginx-cron")
+	if err != nil {
+		t.Fatalf("GetCronFederatedHPADetail() error = %v", err)
+	}
+	if detail.Rules != 2 || detail.SuspendedRules != 1 {
+		t.Errorf("GetCronFederatedHPADetail() rules = %d suspended %d, want 2 suspended 1", detail.Rules, detail.SuspendedRules)
+	}
+	if len(detail.RuleDetails) != 2 {
+		t.Fatalf("GetCronFederatedHPADetail() rule details = %d, want 2", len(detail.RuleDetails))
+	}
+	if got := detail.RuleDetails[0]; got.NextExecutionTime == nil || len(got.SuccessfulExecutions) != 1 {
+		t.Errorf("GetCronFederatedHPADetail() rule %s history = %v, want the scale-up history", got.Name, got)
+	}
+	if got := detail.RuleDetails[1]; got.NextExecutionTime != nil || len(got.SuccessfulExecutions) != 0 {
+		t.Errorf("GetCronFederatedHPADetail() rule %s history = %v, want none", got.Name, got)
+	}
+	if detail.FederatedHPA == nil || detail.FederatedHPA.ObjectMeta.Name != "nginx-hpa" {
+		t.Errorf("GetCronFederatedHPADetail() federated hpa = %v, want nginx-hpa", detail.FederatedHPA)
+	}
+	if want := "/api/v1/topology/default/Deployment/nginx"; detail.ScaleTarget.TopologyPath != want {
+		t.Errorf("GetCronFederatedHPADetail() topology path = %q, want %q", detail.ScaleTarget.TopologyPath, want)
+	}
+}
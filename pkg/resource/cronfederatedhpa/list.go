@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"context"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/federatedhpa"
+)
+
+// CronFederatedHPAList contains a list of CronFederatedHPAs in the karmada control-plane.
+type CronFederatedHPAList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of CronFederatedHPAs.
+	CronFederatedHPAs []CronFederatedHPA `json:"cronFederatedHPAs"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// CronFederatedHPA contains information about a single CronFederatedHPA.
+type CronFederatedHPA struct {
+	ObjectMeta  types.ObjectMeta         `json:"objectMeta"`
+	TypeMeta    types.TypeMeta           `json:"typeMeta"`
+	ScaleTarget federatedhpa.ScaleTarget `json:"scaleTarget"`
+	// Rules and SuspendedRules count the rules of the CronFederatedHPA.
+	Rules          int `json:"rules"`
+	SuspendedRules int `json:"suspendedRules"`
+	// NextExecutionTime is the earliest next execution of the rules.
+	NextExecutionTime *metaV1.Time `json:"nextExecutionTime"`
+}
+
+// GetCronFederatedHPAList returns a list of all CronFederatedHPAs in the Karmada control-plane.
+func GetCronFederatedHPAList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*CronFederatedHPAList, error) {
+	hpas, err := client.AutoscalingV1alpha1().CronFederatedHPAs(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toCronFederatedHPAList(hpas.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toCronFederatedHPAList(hpas []v1alpha1.CronFederatedHPA, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *CronFederatedHPAList {
+	hpaList := &CronFederatedHPAList{
+		CronFederatedHPAs: make([]CronFederatedHPA, 0),
+		ListMeta:          types.ListMeta{TotalItems: len(hpas)},
+	}
+	hpaCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(hpas), dsQuery)
+	hpas = fromCells(hpaCells)
+	hpaList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	hpaList.Errors = nonCriticalErrors
+
+	for i := range hpas {
+		hpaList.CronFederatedHPAs = append(hpaList.CronFederatedHPAs, toCronFederatedHPA(&hpas[i]))
+	}
+	return hpaList
+}
+
+func toCronFederatedHPA(hpa *v1alpha1.CronFederatedHPA) CronFederatedHPA {
+	result := CronFederatedHPA{
+		ObjectMeta:  types.NewObjectMeta(hpa.ObjectMeta),
+		TypeMeta:    types.NewTypeMeta(types.ResourceKindCronFederatedHPA),
+		ScaleTarget: federatedhpa.NewScaleTarget(hpa.Namespace, hpa.Spec.ScaleTargetRef),
+		Rules:       len(hpa.Spec.Rules),
+	}
+	for _, rule := range hpa.Spec.Rules {
+		if rule.Suspend != nil && *rule.Suspend {
+			result.SuspendedRules++
+		}
+	}
+	for _, history := range hpa.Status.ExecutionHistories {
+		next := history.NextExecutionTime
+		if next != nil && (result.NextExecutionTime == nil || next.Before(result.NextExecutionTime)) {
+			result.NextExecutionTime = next
+		}
+	}
+	return result
+}
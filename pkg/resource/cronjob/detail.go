@@ -22,6 +22,8 @@ import (
 	batch "k8s.io/api/batch/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClient "k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 // CronJobDetail contains Cron Job details.
@@ -32,6 +34,9 @@ type CronJobDetail struct {
 	ConcurrencyPolicy       string `json:"concurrencyPolicy"`
 	StartingDeadLineSeconds *int64 `json:"startingDeadlineSeconds"`
 
+	// Status of the CronJob in every member cluster it is scheduled to.
+	MemberStatus []common.MemberStatus `json:"memberStatus"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -52,5 +57,6 @@ func toCronJobDetail(cj *batch.CronJob) CronJobDetail {
 		CronJob:                 toCronJob(cj),
 		ConcurrencyPolicy:       string(cj.Spec.ConcurrencyPolicy),
 		StartingDeadLineSeconds: cj.Spec.StartingDeadlineSeconds,
+		MemberStatus:            common.GetMemberStatuses(cj.UID),
 	}
 }
@@ -52,6 +52,10 @@ type CronJob struct {
 
 	// ContainerImages holds a list of the CronJob images.
 	ContainerImages []string `json:"containerImages"`
+
+	// Member clusters the CronJob is scheduled to and healthy in, only set for resource templates
+	// listed from the Karmada control plane.
+	Clusters *common.ClusterSummary `json:"clusters,omitempty"`
 }
 
 // GetCronJobList returns a list of all CronJobs in the cluster.
@@ -110,3 +114,11 @@ func toCronJob(cj *batch.CronJob) CronJob {
 		ContainerImages: getContainerImages(cj),
 	}
 }
+
+// AddClusterSummaries sets the member clusters of every CronJob of the list, which must have been
+// listed from the Karmada control plane.
+func (list *CronJobList) AddClusterSummaries() {
+	for i := range list.Items {
+		list.Items[i].Clusters = common.GetClusterSummary(list.Items[i].ObjectMeta.UID)
+	}
+}
@@ -33,6 +33,9 @@ type DaemonSetDetail struct {
 
 	LabelSelector *metaV1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// Status of the DaemonSet in every member cluster it is scheduled to.
+	MemberStatus []common.MemberStatus `json:"memberStatus"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -63,6 +66,7 @@ func GetDaemonSetDetail(client k8sClient.Interface, namespace, name string) (*Da
 	return &DaemonSetDetail{
 		DaemonSet:     toDaemonSet(*daemonSet, podList.Items, eventList.Items),
 		LabelSelector: daemonSet.Spec.Selector,
+		MemberStatus:  common.GetMemberStatuses(daemonSet.UID),
 		Errors:        []error{},
 	}, nil
 }
@@ -45,6 +45,10 @@ type DaemonSet struct {
 	Pods                common.PodInfo   `json:"podInfo"`
 	ContainerImages     []string         `json:"containerImages"`
 	InitContainerImages []string         `json:"initContainerImages"`
+
+	// Member clusters the DaemonSet is scheduled to and healthy in, only set for resource templates
+	// listed from the Karmada control plane.
+	Clusters *common.ClusterSummary `json:"clusters,omitempty"`
 }
 
 // GetDaemonSetList returns a list of all Daemon Set in the cluster.
@@ -121,3 +125,11 @@ func toDaemonSet(daemonSet apps.DaemonSet, pods []v1.Pod, events []v1.Event) Dae
 		InitContainerImages: common.GetInitContainerImages(&daemonSet.Spec.Template.Spec),
 	}
 }
+
+// AddClusterSummaries sets the member clusters of every DaemonSet of the list, which must have been
+// listed from the Karmada control plane.
+func (list *DaemonSetList) AddClusterSummaries() {
+	for i := range list.DaemonSets {
+		list.DaemonSets[i].Clusters = common.GetClusterSummary(list.DaemonSets[i].ObjectMeta.UID)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	client "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// RestartedAtAnnotation is set on the pod template to restart a deployment, like kubectl rollout restart.
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// ActionResult is the outcome of a lifecycle action on a deployment resource template.
+type ActionResult struct {
+	Deployment *apps.Deployment `json:"deployment"`
+	// Warnings lists the side effects worth knowing about, e.g. an autoscaler owning the replicas.
+	Warnings []string `json:"warnings"`
+	// Clusters is the status collected from the member clusters the deployment is propagated to.
+	// The action reaches the members asynchronously, so it may still show the previous state.
+	Clusters []workv1alpha2.AggregatedStatusItem `json:"clusters"`
+}
+
+// ScaleDeployment sets the replicas of a deployment resource template.
+func ScaleDeployment(ctx context.Context, k8sClient client.Interface, karmadaClient karmadaclientset.Interface,
+	namespace, name string, replicas int32) (*ActionResult, error) {
+	warnings, err := autoscalerWarnings(ctx, karmadaClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return patchDeployment(ctx, k8sClient, karmadaClient, namespace, name, warnings, map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": replicas},
+	})
+}
+
+// RestartDeployment restarts the pods of a deployment in every member cluster by stamping the pod
+// template, the same way kubectl rollout restart does.
+func RestartDeployment(ctx context.Context, k8sClient client.Interface, karmadaClient karmadaclientset.Interface,
+	namespace, name string) (*ActionResult, error) {
+	return patchDeployment(ctx, k8sClient, karmadaClient, namespace, name, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{RestartedAtAnnotation: time.Now().Format(time.RFC3339)},
+				},
+			},
+		},
+	})
+}
+
+// PauseDeployment pauses the rollout of a deployment, resume reverts it.
+func PauseDeployment(ctx context.Context, k8sClient client.Interface, karmadaClient karmadaclientset.Interface,
+	namespace, name string, paused bool) (*ActionResult, error) {
+	return patchDeployment(ctx, k8sClient, karmadaClient, namespace, name, nil, map[string]interface{}{
+		"spec": map[string]interface{}{"paused": paused},
+	})
+}
+
+// RollbackDeployment restores the pod template of a deployment.
+func RollbackDeployment(ctx context.Context, k8sClient client.Interface, karmadaClient karmadaclientset.Interface,
+	namespace, name string, template v1.PodTemplateSpec) (*ActionResult, error) {
+	var deployment *apps.Deployment
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := k8sClient.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		current.Spec.Template = template
+		deployment, err = k8sClient.AppsV1().Deployments(namespace).Update(ctx, current, metaV1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newActionResult(ctx, karmadaClient, deployment, nil)
+}
+
+func patchDeployment(ctx context.Context, k8sClient client.Interface, karmadaClient karmadaclientset.Interface,
+	namespace, name string, warnings []string, patch map[string]interface{}) (*ActionResult, error) {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := k8sClient.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, metaV1.PatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return newActionResult(ctx, karmadaClient, deployment, warnings)
+}
+
+func newActionResult(ctx context.Context, karmadaClient karmadaclientset.Interface,
+	deployment *apps.Deployment, warnings []string) (*ActionResult, error) {
+	result := &ActionResult{Deployment: deployment, Warnings: warnings, Clusters: make([]workv1alpha2.AggregatedStatusItem, 0)}
+	if result.Warnings == nil {
+		result.Warnings = make([]string, 0)
+	}
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(deployment.Namespace).Get(ctx,
+		names.GenerateBindingName("Deployment", deployment.Name), metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		result.Warnings = append(result.Warnings, "the deployment is not propagated to any member cluster")
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Clusters = append(result.Clusters, binding.Status.AggregatedStatus...)
+	return result, nil
+}
+
+// autoscalerWarnings warns about the FederatedHPAs and CronFederatedHPAs scaling the deployment,
+// which overwrite a manually set replica count.
+func autoscalerWarnings(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, name string) ([]string, error) {
+	warnings := make([]string, 0)
+	isTarget := func(kind, apiVersion, targetName string) bool {
+		return kind == "Deployment" && targetName == name && (apiVersion == "" || apiVersion == apps.SchemeGroupVersion.String())
+	}
+	fhpas, err := karmadaClient.AutoscalingV1alpha1().FederatedHPAs(namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, fhpa := range fhpas.Items {
+		ref := fhpa.Spec.ScaleTargetRef
+		if isTarget(ref.Kind, ref.APIVersion, ref.Name) {
+			warnings = append(warnings, fmt.Sprintf(
+				"FederatedHPA %s owns the replica count of the deployment and will overwrite it", fhpa.Name))
+		}
+	}
+	cronFhpas, err := karmadaClient.AutoscalingV1alpha1().CronFederatedHPAs(namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, cronFhpa := range cronFhpas.Items {
+		ref := cronFhpa.Spec.ScaleTargetRef
+		if isTarget(ref.Kind, ref.APIVersion, ref.Name) {
+			warnings = append(warnings, fmt.Sprintf(
+				"CronFederatedHPA %s scales the deployment on a schedule and will overwrite the replica count", cronFhpa.Name))
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	apps "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestScaleDeployment(t *testing.T) {
+	replicas := int32(1)
+	k8sClient := fake.NewSimpleClientset(&apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default"},
+		Spec:       apps.DeploymentSpec{Replicas: &replicas},
+	})
+	karmadaClient := karmadafake.NewSimpleClientset(&autoscalingv1alpha1.FederatedHPA{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx-hpa", Namespace: "default"},
+		Spec: autoscalingv1alpha1.FederatedHPASpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", APIVersion: "apps/v1", Name: "nginx"},
+		},
+	}, &autoscalingv1alpha1.FederatedHPA{
+		ObjectMeta: metaV1.ObjectMeta{Name: "other-hpa", Namespace: "default"},
+		Spec: autoscalingv1alpha1.FederatedHPASpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", APIVersion: "apps/v1", Name: "other"},
+		},
+	})
+
+	result, err := ScaleDeployment(context.TODO(), k8sClient, karmadaClient, "default", "nginx", 3)
+	if err != nil {
+		t.Fatalf("ScaleDeployment() error = %v", err)
+	}
+	if *result.Deployment.Spec.Replicas != 3 {
+		t.Errorf("expected 3 replicas, got %d", *result.Deployment.Spec.Replicas)
+	}
+	// one warning for nginx-hpa and one for the missing ResourceBinding
+	if len(result.Warnings) != 2 {
+		t.Errorf("expected 2 warnings, got %v", result.Warnings)
+	}
+}
+
+func TestRestartDeployment(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&apps.Deployment{ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default"}})
+	result, err := RestartDeployment(context.TODO(), k8sClient, karmadafake.NewSimpleClientset(), "default", "nginx")
+	if err != nil {
+		t.Fatalf("RestartDeployment() error = %v", err)
+	}
+	if result.Deployment.Spec.Template.Annotations[RestartedAtAnnotation] == "" {
+		t.Errorf("expected the %s annotation to be set", RestartedAtAnnotation)
+	}
+}
@@ -78,6 +78,9 @@ type DeploymentDetail struct {
 	// Optional field that specifies the number of old Replica Sets to retain to allow rollback.
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit"`
 
+	// Status of the deployment in every member cluster it is scheduled to.
+	MemberStatus []common.MemberStatus `json:"memberStatus"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -145,6 +148,7 @@ func GetDeploymentDetail(client client.Interface, namespace string, deploymentNa
 		MinReadySeconds:       deployment.Spec.MinReadySeconds,
 		RollingUpdateStrategy: rollingUpdateStrategy,
 		RevisionHistoryLimit:  deployment.Spec.RevisionHistoryLimit,
+		MemberStatus:          common.GetMemberStatuses(deployment.UID),
 		Errors:                nonCriticalErrors,
 	}, nil
 }
@@ -59,6 +59,10 @@ type Deployment struct {
 
 	// Init Container images of the Deployment.
 	InitContainerImages []string `json:"initContainerImages"`
+
+	// Member clusters the Deployment is scheduled to and healthy in, only set for resource templates
+	// listed from the Karmada control plane.
+	Clusters *common.ClusterSummary `json:"clusters,omitempty"`
 }
 
 // GetDeploymentList returns a list of all Deployments in the cluster.
@@ -144,3 +148,11 @@ func toDeployment(deployment *apps.Deployment, rs []apps.ReplicaSet, pods []v1.P
 		InitContainerImages: common.GetInitContainerImages(&deployment.Spec.Template.Spec),
 	}
 }
+
+// AddClusterSummaries sets the member clusters of every Deployment of the list, which must have been
+// listed from the Karmada control plane.
+func (list *DeploymentList) AddClusterSummaries() {
+	for i := range list.Deployments {
+		list.Deployments[i].Clusters = common.GetClusterSummary(list.Deployments[i].ObjectMeta.UID)
+	}
+}
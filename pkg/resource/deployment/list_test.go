@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+func TestGetDeploymentListClusterSummaries(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default", UID: "uid"},
+	})
+
+	// a member cluster list must not report member clusters of its own
+	list, err := GetDeploymentList(k8sClient, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetDeploymentList() error = %v", err)
+	}
+	if len(list.Deployments) != 1 || list.Deployments[0].Clusters != nil {
+		t.Fatalf("expected one Deployment without clusters, got %+v", list.Deployments)
+	}
+
+	list.AddClusterSummaries()
+	if list.Deployments[0].Clusters == nil {
+		t.Errorf("expected AddClusterSummaries() to set the clusters")
+	}
+}
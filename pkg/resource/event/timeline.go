@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+// The sources of the events of a workload timeline.
+const (
+	SourceTemplate     = "ResourceTemplate"
+	SourceBinding      = "ResourceBinding"
+	SourceWork         = "Work"
+	SourceMemberObject = "MemberObject"
+	SourcePod          = "Pod"
+)
+
+// TimelineEvent is an event of a workload timeline, Cluster is empty for control plane events
+// other than the ones of Works.
+type TimelineEvent struct {
+	common.Event
+	Source  string `json:"source"`
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// Timeline is the merged list of the events of a workload.
+type Timeline struct {
+	ListMeta types.ListMeta  `json:"listMeta"`
+	Events   []TimelineEvent `json:"events"`
+	// Errors lists the clusters whose events could not be read.
+	Errors []error `json:"errors"`
+}
+
+// memberClientFor and workloadPods reach the member clusters, they are replaced in tests.
+var (
+	memberClientFor = client.InClusterClientForMemberCluster
+	workloadPods    = topology.GetWorkloadPods
+)
+
+// sourcedEvent is an api event together with where it was read from.
+type sourcedEvent struct {
+	event   v1.Event
+	source  string
+	cluster string
+}
+
+// GetWorkloadTimeline merges the events of a resource template, its ResourceBinding and Works in the
+// control plane with the events of the member objects and their pods. Events repeating a reason
+// of the same source and cluster are dropped, only the latest is kept.
+func GetWorkloadTimeline(ctx context.Context, k8sClient kubernetes.Interface, karmadaClient karmadaclientset.Interface,
+	namespace, kind, name string, warningsOnly bool, dsQuery *dataselect.DataSelectQuery) (*Timeline, error) {
+	bindingName := names.GenerateBindingName(kind, name)
+	events := make([]sourcedEvent, 0)
+	nonCriticalErrors := make([]error, 0)
+
+	namespaceEvents, err := k8sClient.CoreV1().Events(namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range namespaceEvents.Items {
+		switch {
+		case event.InvolvedObject.Name == name && strings.EqualFold(event.InvolvedObject.Kind, kind):
+			events = append(events, sourcedEvent{event: event, source: SourceTemplate})
+		case event.InvolvedObject.Name == bindingName && event.InvolvedObject.Kind == workv1alpha2.ResourceKindResourceBinding:
+			events = append(events, sourcedEvent{event: event, source: SourceBinding})
+		}
+	}
+
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, bindingName, metaV1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	clusters := make([]string, 0)
+	if binding != nil && binding.Labels[workv1alpha2.ResourceBindingPermanentIDLabel] != "" {
+		works, err := karmadaClient.WorkV1alpha1().Works(metaV1.NamespaceAll).List(ctx, metaV1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(labels.Set{
+				workv1alpha2.ResourceBindingPermanentIDLabel: binding.Labels[workv1alpha2.ResourceBindingPermanentIDLabel],
+			}).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, work := range works.Items {
+			cluster, err := names.GetClusterName(work.Namespace)
+			if err != nil {
+				continue
+			}
+			clusters = append(clusters, cluster)
+			workEvents, err := GetEvents(k8sClient, work.Namespace, work.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, event := range workEvents {
+				events = append(events, sourcedEvent{event: event, source: SourceWork, cluster: cluster})
+			}
+		}
+	}
+
+	pods, podErrors, err := workloadPods(ctx, k8sClient, namespace, name, kind)
+	if err != nil {
+		nonCriticalErrors = append(nonCriticalErrors, err)
+	}
+	nonCriticalErrors = append(nonCriticalErrors, podErrors...)
+	podsByCluster := make(map[string][]v1.Pod)
+	for _, clusterPods := range pods {
+		for _, pod := range clusterPods.Pods {
+			podsByCluster[clusterPods.Cluster] = append(podsByCluster[clusterPods.Cluster], *pod)
+		}
+	}
+
+	sort.Strings(clusters)
+	for _, cluster := range clusters {
+		memberClient := memberClientFor(cluster)
+		if memberClient == nil {
+			nonCriticalErrors = append(nonCriticalErrors, fmt.Errorf("cluster %s: no client", cluster))
+			continue
+		}
+		memberEvents, err := memberClient.CoreV1().Events(namespace).List(ctx, metaV1.ListOptions{})
+		if err != nil {
+			nonCriticalErrors = append(nonCriticalErrors, fmt.Errorf("cluster %s: %w", cluster, err))
+			continue
+		}
+		for _, event := range filterEventsByPodsUID(memberEvents.Items, podsByCluster[cluster]) {
+			events = append(events, sourcedEvent{event: event, source: SourcePod, cluster: cluster})
+		}
+		for _, event := range memberEvents.Items {
+			if event.InvolvedObject.Name == name && strings.EqualFold(event.InvolvedObject.Kind, kind) {
+				events = append(events, sourcedEvent{event: event, source: SourceMemberObject, cluster: cluster})
+			}
+		}
+	}
+
+	events = dedupTimeline(events, warningsOnly)
+	timeline := &Timeline{
+		ListMeta: types.ListMeta{TotalItems: len(events)},
+		Events:   make([]TimelineEvent, 0, len(events)),
+		Errors:   nonCriticalErrors,
+	}
+	for _, cell := range dataselect.GenericDataSelect(toTimelineCells(events), dsQuery) {
+		event := cell.(timelineCell)
+		timeline.Events = append(timeline.Events, TimelineEvent{
+			Event:   ToEvent(event.event),
+			Source:  event.source,
+			Cluster: event.cluster,
+		})
+	}
+	return timeline, nil
+}
+
+// dedupTimeline sorts the events newest first, drops the normal events when warningsOnly is set and
+// removes the repeated reasons of every source and cluster.
+func dedupTimeline(events []sourcedEvent, warningsOnly bool) []sourcedEvent {
+	sort.SliceStable(events, func(i, j int) bool {
+		return lastSeen(events[i].event).After(lastSeen(events[j].event))
+	})
+	type group struct{ source, cluster string }
+	order := make([]group, 0)
+	grouped := make(map[group][]v1.Event)
+	for _, e := range events {
+		g := group{source: e.source, cluster: e.cluster}
+		if _, ok := grouped[g]; !ok {
+			order = append(order, g)
+		}
+		grouped[g] = append(grouped[g], e.event)
+	}
+
+	result := make([]sourcedEvent, 0, len(events))
+	for _, g := range order {
+		groupEvents := FillEventsType(grouped[g])
+		if warningsOnly {
+			groupEvents = filterEventsByType(groupEvents, v1.EventTypeWarning)
+		}
+		for _, event := range removeDuplicates(groupEvents) {
+			result = append(result, sourcedEvent{event: event, source: g.source, cluster: g.cluster})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return lastSeen(result[i].event).After(lastSeen(result[j].event))
+	})
+	return result
+}
+
+// lastSeen returns when the event last occurred, the way ToEvent computes LastSeen.
+func lastSeen(event v1.Event) time.Time {
+	return ToEvent(event).LastSeen.Time
+}
+
+// timelineCell wraps a sourced event for data selection.
+type timelineCell sourcedEvent
+
+// GetProperty returns a property of the cell.
+func (c timelineCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	return EventCell(c.event).GetProperty(name)
+}
+
+func toTimelineCells(events []sourcedEvent) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(events))
+	for i := range events {
+		cells[i] = timelineCell(events[i])
+	}
+	return cells
+}
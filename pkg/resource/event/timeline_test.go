@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+func newEvent(name, namespace, kind, object string, uid types.UID, eventType, reason string, age time.Duration) *v1.Event {
+	return &v1.Event{
+		ObjectMeta:     metaV1.ObjectMeta{Name: name, Namespace: namespace},
+		InvolvedObject: v1.ObjectReference{Kind: kind, Name: object, Namespace: namespace, UID: uid},
+		Type:           eventType,
+		Reason:         reason,
+		LastTimestamp:  metaV1.NewTime(time.Now().Add(-age)),
+	}
+}
+
+func TestGetWorkloadTimeline(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		newEvent("e1", "default", "Deployment", "nginx", "", v1.EventTypeNormal, "SyncSucceed", 5*time.Minute),
+		newEvent("e2", "default", "ResourceBinding", "nginx-deployment", "", v1.EventTypeWarning, "ScheduleBindingFailed", 4*time.Minute),
+		newEvent("e3", "karmada-es-member1", "Work", "nginx-work", "", v1.EventTypeWarning, "SyncFailed", 3*time.Minute),
+		newEvent("e4", "default", "Deployment", "other", "", v1.EventTypeWarning, "SyncFailed", time.Minute),
+	)
+	karmadaClient := karmadafake.NewSimpleClientset(
+		&workv1alpha2.ResourceBinding{ObjectMeta: metaV1.ObjectMeta{
+			Name: "nginx-deployment", Namespace: "default",
+			Labels: map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "id"},
+		}},
+		&workv1alpha1.Work{ObjectMeta: metaV1.ObjectMeta{
+			Name: "nginx-work", Namespace: "karmada-es-member1",
+			Labels: map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "id"},
+		}},
+	)
+	memberClient := fake.NewSimpleClientset(
+		newEvent("m1", "default", "Deployment", "nginx", "", v1.EventTypeNormal, "ScalingReplicaSet", 2*time.Minute),
+		newEvent("m2", "default", "Pod", "nginx-a", "pod-a", v1.EventTypeWarning, "BackOff", 90*time.Second),
+		newEvent("m3", "default", "Pod", "nginx-b", "pod-b", v1.EventTypeWarning, "BackOff", time.Minute),
+		newEvent("m4", "default", "Pod", "unrelated", "pod-c", v1.EventTypeWarning, "Failed", time.Minute),
+	)
+	memberClientFor = func(string) kubernetes.Interface { return memberClient }
+	workloadPods = func(context.Context, kubernetes.Interface, string, string, string) ([]topology.ClusterPods, []error, error) {
+		return []topology.ClusterPods{{Cluster: "member1", Pods: []*v1.Pod{
+			{ObjectMeta: metaV1.ObjectMeta{Name: "nginx-a", UID: "pod-a"}},
+			{ObjectMeta: metaV1.ObjectMeta{Name: "nginx-b", UID: "pod-b"}},
+		}}}, nil, nil
+	}
+
+	timeline, err := GetWorkloadTimeline(context.TODO(), k8sClient, karmadaClient, "default", "Deployment", "nginx", false, dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetWorkloadTimeline() error = %v", err)
+	}
+	expected := []struct{ source, cluster, reason string }{
+		{SourcePod, "member1", "BackOff"},
+		{SourceMemberObject, "member1", "ScalingReplicaSet"},
+		{SourceWork, "member1", "SyncFailed"},
+		{SourceBinding, "", "ScheduleBindingFailed"},
+		{SourceTemplate, "", "SyncSucceed"},
+	}
+	if len(timeline.Events) != len(expected) {
+		t.Fatalf("expected %d events, got %+v", len(expected), timeline.Events)
+	}
+	for i, e := range expected {
+		got := timeline.Events[i]
+		if got.Source != e.source || got.Cluster != e.cluster || got.Reason != e.reason {
+			t.Errorf("event %d: expected %s/%s/%s, got %s/%s/%s", i, e.source, e.cluster, e.reason, got.Source, got.Cluster, got.Reason)
+		}
+	}
+	// the duplicated BackOff keeps the latest pod event
+	if timeline.Events[0].SubObjectName != "nginx-b" {
+		t.Errorf("expected the latest BackOff event to be kept, got %s", timeline.Events[0].SubObjectName)
+	}
+
+	warnings, err := GetWorkloadTimeline(context.TODO(), k8sClient, karmadaClient, "default", "Deployment", "nginx", true, dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetWorkloadTimeline() error = %v", err)
+	}
+	if warnings.ListMeta.TotalItems != 3 {
+		t.Errorf("expected 3 warning events, got %+v", warnings.Events)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"fmt"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// ScaleTarget is the object an autoscaler scales. TopologyPath is the api path of the topology of
+// the workload that is scaled in the end, empty when it is unknown.
+type ScaleTarget struct {
+	autoscalingv2.CrossVersionObjectReference `json:",inline"`
+	Namespace                                 string `json:"namespace"`
+	TopologyPath                              string `json:"topologyPath,omitempty"`
+}
+
+// topologyKinds are the workload kinds the topology api supports.
+var topologyKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+	"Job":         true,
+	"CronJob":     true,
+}
+
+// NewScaleTarget returns the scale target of an autoscaler in the namespace.
+func NewScaleTarget(namespace string, ref autoscalingv2.CrossVersionObjectReference) ScaleTarget {
+	target := ScaleTarget{CrossVersionObjectReference: ref, Namespace: namespace}
+	if topologyKinds[ref.Kind] {
+		target.TopologyPath = fmt.Sprintf("/api/v1/topology/%s/%s/%s", namespace, ref.Kind, ref.Name)
+	}
+	return target
+}
+
+// FederatedHPACell represents a FederatedHPA that implements the DataCell interface.
+type FederatedHPACell v1alpha1.FederatedHPA
+
+// GetProperty returns a comparable value for a specified property name.
+func (c FederatedHPACell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []v1alpha1.FederatedHPA) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = FederatedHPACell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []v1alpha1.FederatedHPA {
+	std := make([]v1alpha1.FederatedHPA, len(cells))
+	for i := range std {
+		std[i] = v1alpha1.FederatedHPA(cells[i].(FederatedHPACell))
+	}
+	return std
+}
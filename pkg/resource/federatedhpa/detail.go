@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// FederatedHPADetail is a presentation layer view of a Karmada FederatedHPA, with the metrics the
+// FederatedHPA controller collected through karmada-metrics-adapter.
+type FederatedHPADetail struct {
+	// Extends list item structure.
+	FederatedHPA `json:",inline"`
+
+	Metrics        []autoscalingv2.MetricSpec                       `json:"metrics"`
+	CurrentMetrics []autoscalingv2.MetricStatus                     `json:"currentMetrics"`
+	Behavior       *autoscalingv2.HorizontalPodAutoscalerBehavior   `json:"behavior"`
+	Conditions     []autoscalingv2.HorizontalPodAutoscalerCondition `json:"conditions"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetFederatedHPADetail gets FederatedHPA details.
+func GetFederatedHPADetail(client karmadaclientset.Interface, namespace, name string) (*FederatedHPADetail, error) {
+	hpa, err := client.AutoscalingV1alpha1().FederatedHPAs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &FederatedHPADetail{
+		FederatedHPA:   toFederatedHPA(hpa),
+		Metrics:        hpa.Spec.Metrics,
+		CurrentMetrics: hpa.Status.CurrentMetrics,
+		Behavior:       hpa.Spec.Behavior,
+		Conditions:     hpa.Status.Conditions,
+		Errors:         []error{},
+	}, nil
+}
+
+// CreateFederatedHPA creates a FederatedHPA from its YAML content in the namespace.
+func CreateFederatedHPA(ctx context.Context, client karmadaclientset.Interface, namespace, content string) (*v1alpha1.FederatedHPA, error) {
+	hpa := &v1alpha1.FederatedHPA{}
+	if err := yaml.UnmarshalStrict([]byte(content), hpa); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid FederatedHPA: %v", err))
+	}
+	if hpa.Namespace != "" && hpa.Namespace != namespace {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("FederatedHPA namespace %s does not match namespace %s", hpa.Namespace, namespace))
+	}
+	hpa.Namespace = namespace
+	return client.AutoscalingV1alpha1().FederatedHPAs(namespace).Create(ctx, hpa, metaV1.CreateOptions{})
+}
+
+// UpdateFederatedHPA replaces the spec of a FederatedHPA with the one of its YAML content, the
+// metadata and status are kept.
+func UpdateFederatedHPA(ctx context.Context, client karmadaclientset.Interface, namespace, name, content string) (*v1alpha1.FederatedHPA, error) {
+	hpa := &v1alpha1.FederatedHPA{}
+	if err := yaml.UnmarshalStrict([]byte(content), hpa); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid FederatedHPA: %v", err))
+	}
+	current, err := client.AutoscalingV1alpha1().FederatedHPAs(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	current.Spec = hpa.Spec
+	return client.AutoscalingV1alpha1().FederatedHPAs(namespace).Update(ctx, current, metaV1.UpdateOptions{})
+}
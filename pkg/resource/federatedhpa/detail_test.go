@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetFederatedHPADetail(t *testing.T) {
+	hpa := &v1alpha1.FederatedHPA{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Spec: v1alpha1.FederatedHPASpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"},
+			MinReplicas:    ptr.To[int32](1),
+			MaxReplicas:    10,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 2,
+			DesiredReplicas: 4,
+			CurrentMetrics: []autoscalingv2.MetricStatus{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    "cpu",
+					Current: autoscalingv2.MetricValueStatus{AverageValue: ptr.To(resource.MustParse("200m"))},
+				},
+			}},
+		},
+	}
+	client := karmadafake.NewSimpleClientset(hpa)
+
+	detail, err := GetFederatedHPADetail(client, "default", "nginx")
+	if err != nil {
+		t.Fatalf("GetFederatedHPADetail() error = %v", err)
+	}
+	if detail.CurrentReplicas != 2 || detail.DesiredReplicas != 4 || detail.MaxReplicas != 10 {
+		t.Errorf("GetFederatedHPADetail() replicas = %d/%d max %d, want 2/4 max 10",
+			detail.CurrentReplicas, detail.DesiredReplicas, detail.MaxReplicas)
+	}
+	if len(detail.CurrentMetrics) != 1 {
+		t.Errorf("GetFederatedHPADetail() current metrics = %v, want 1", detail.CurrentMetrics)
+	}
+	if want := "/api/v1/topology/default/Deployment/nginx"; detail.ScaleTarget.TopologyPath != want {
+		t.Errorf("GetFederatedHPADetail() topology path = %q, want %q", detail.ScaleTarget.TopologyPath, want)
+	}
+}
+
+func TestNewScaleTarget(t *testing.T) {
+	target := NewScaleTarget("default", autoscalingv2.CrossVersionObjectReference{Kind: "FederatedHPA", Name: "nginx"})
+	if target.TopologyPath != "" {
+		t.Errorf("NewScaleTarget() topology path = %q, want empty for a non workload kind", target.TopologyPath)
+	}
+}
+
+func TestCreateAndUpdateFederatedHPA(t *testing.T) {
+	client := karmadafake.NewSimpleClientset()
+	content := `apiVersion: autoscaling.karmada.io/v1alpha1
+kind: FederatedHPA
+metadata:
+  name: nginx
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: nginx
+  maxReplicas: 10
+`
+	if _, err := CreateFederatedHPA(context.TODO(), client, "other", content+"  unknown: true\n"); err == nil {
+		t.Errorf("CreateFederatedHPA() with an unknown field error = nil, want error")
+	}
+	created, err := CreateFederatedHPA(context.TODO(), client, "default", content)
+	if err != nil {
+		t.Fatalf("CreateFederatedHPA() error = %v", err)
+	}
+	if created.Namespace != "default" {
+		t.Errorf("CreateFederatedHPA() namespace = %q, want default", created.Namespace)
+	}
+
+	updated, err := UpdateFederatedHPA(context.TODO(), client, "default", "nginx",
+		"spec:\n  scaleTargetRef:\n    kind: Deployment\n    name: nginx\n  maxReplicas: 20\n")
+	if err != nil {
+		t.Fatalf("UpdateFederatedHPA() error = %v", err)
+	}
+	if updated.Spec.MaxReplicas != 20 || updated.Name != "nginx" {
+		t.Errorf("UpdateFederatedHPA() = %s max %d, want nginx max 20", updated.Name, updated.Spec.MaxReplicas)
+	}
+}
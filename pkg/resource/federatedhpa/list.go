@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"context"
+
+	"github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// FederatedHPAList contains a list of FederatedHPAs in the karmada control-plane.
+type FederatedHPAList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of FederatedHPAs.
+	FederatedHPAs []FederatedHPA `json:"federatedHPAs"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// FederatedHPA contains information about a single FederatedHPA.
+type FederatedHPA struct {
+	ObjectMeta  types.ObjectMeta `json:"objectMeta"`
+	TypeMeta    types.TypeMeta   `json:"typeMeta"`
+	ScaleTarget ScaleTarget      `json:"scaleTarget"`
+	MinReplicas *int32           `json:"minReplicas"`
+	MaxReplicas int32            `json:"maxReplicas"`
+	// CurrentReplicas and DesiredReplicas are summed over the member clusters.
+	CurrentReplicas int32        `json:"currentReplicas"`
+	DesiredReplicas int32        `json:"desiredReplicas"`
+	LastScaleTime   *metaV1.Time `json:"lastScaleTime"`
+}
+
+// GetFederatedHPAList returns a list of all FederatedHPAs in the Karmada control-plane.
+func GetFederatedHPAList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*FederatedHPAList, error) {
+	hpas, err := client.AutoscalingV1alpha1().FederatedHPAs(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toFederatedHPAList(hpas.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toFederatedHPAList(hpas []v1alpha1.FederatedHPA, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *FederatedHPAList {
+	hpaList := &FederatedHPAList{
+		FederatedHPAs: make([]FederatedHPA, 0),
+		ListMeta:      types.ListMeta{TotalItems: len(hpas)},
+	}
+	hpaCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(hpas), dsQuery)
+	hpas = fromCells(hpaCells)
+	hpaList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	hpaList.Errors = nonCriticalErrors
+
+	for i := range hpas {
+		hpaList.FederatedHPAs = append(hpaList.FederatedHPAs, toFederatedHPA(&hpas[i]))
+	}
+	return hpaList
+}
+
+func toFederatedHPA(hpa *v1alpha1.FederatedHPA) FederatedHPA {
+	return FederatedHPA{
+		ObjectMeta:      types.NewObjectMeta(hpa.ObjectMeta),
+		TypeMeta:        types.NewTypeMeta(types.ResourceKindFederatedHPA),
+		ScaleTarget:     NewScaleTarget(hpa.Namespace, hpa.Spec.ScaleTargetRef),
+		MinReplicas:     hpa.Spec.MinReplicas,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		LastScaleTime:   hpa.Status.LastScaleTime,
+	}
+}
@@ -35,6 +35,9 @@ type JobDetail struct {
 	// Completions specifies the desired number of successfully finished pods the job should be run with.
 	Completions *int32 `json:"completions"`
 
+	// Status of the Job in every member cluster it is scheduled to.
+	MemberStatus []common.MemberStatus `json:"memberStatus"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -58,8 +61,9 @@ func GetJobDetail(client k8sClient.Interface, namespace, name string) (*JobDetai
 
 func toJobDetail(job *batch.Job, podInfo common.PodInfo, nonCriticalErrors []error) JobDetail {
 	return JobDetail{
-		Job:         toJob(job, &podInfo),
-		Completions: job.Spec.Completions,
-		Errors:      nonCriticalErrors,
+		Job:          toJob(job, &podInfo),
+		Completions:  job.Spec.Completions,
+		MemberStatus: common.GetMemberStatuses(job.UID),
+		Errors:       nonCriticalErrors,
 	}
 }
@@ -86,6 +86,10 @@ type Job struct {
 
 	// JobStatus contains inferred job status based on job conditions
 	JobStatus JobStatus `json:"jobStatus"`
+
+	// Member clusters the Job is scheduled to and healthy in, only set for resource templates
+	// listed from the Karmada control plane.
+	Clusters *common.ClusterSummary `json:"clusters,omitempty"`
 }
 
 // GetJobList returns a list of all Jobs in the cluster.
@@ -195,3 +199,11 @@ func getJobConditions(job *batch.Job) []common.Condition {
 	}
 	return conditions
 }
+
+// AddClusterSummaries sets the member clusters of every Job of the list, which must have been
+// listed from the Karmada control plane.
+func (list *JobList) AddClusterSummaries() {
+	for i := range list.Jobs {
+		list.Jobs[i].Clusters = common.GetClusterSummary(list.Jobs[i].ObjectMeta.UID)
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logs streams the container logs of a workload from many member clusters as one stream.
+package logs
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+const (
+	// MaxSources bounds how many containers are streamed at the same time.
+	MaxSources = 50
+	// reorderWindow is how long lines are held back so that lines of slower streams can be
+	// merged in timestamp order.
+	reorderWindow = time.Second
+	// maxPending bounds the lines held back, older lines are emitted once it is reached.
+	maxPending = 10000
+	// maxLineSize bounds the size of a single log line.
+	maxLineSize = 1024 * 1024
+)
+
+// Source is a container of a pod in a member cluster.
+type Source struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+}
+
+// Line is a log line of a source.
+type Line struct {
+	Cluster   string    `json:"cluster"`
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	Timestamp time.Time `json:"timestamp"`
+	Content   string    `json:"content"`
+}
+
+// Options are the options of the log stream.
+type Options struct {
+	SinceSeconds *int64
+	TailLines    *int64
+	// Filter keeps only the lines whose content matches, every line when nil.
+	Filter *regexp.Regexp
+	// Previous streams the logs of the previous instance of the containers.
+	Previous bool
+	Follow   bool
+}
+
+// Sources returns the containers of the pods, only the named container when container is set.
+func Sources(clusterPods []topology.ClusterPods, container string) []Source {
+	sources := make([]Source, 0)
+	for _, cp := range clusterPods {
+		for _, pod := range cp.Pods {
+			for _, c := range pod.Spec.Containers {
+				if container != "" && c.Name != container {
+					continue
+				}
+				sources = append(sources, Source{Cluster: cp.Cluster, Namespace: pod.Namespace, Pod: pod.Name, Container: c.Name})
+			}
+		}
+	}
+	return sources
+}
+
+// openStream opens the log stream of a source through the cluster proxy, replaced in tests.
+var openStream = func(ctx context.Context, source Source, logOptions *corev1.PodLogOptions) (io.ReadCloser, error) {
+	memberClient := client.InClusterClientForMemberCluster(source.Cluster)
+	if memberClient == nil {
+		return nil, fmt.Errorf("unable to get client for member cluster %s", source.Cluster)
+	}
+	return memberClient.CoreV1().Pods(source.Namespace).GetLogs(source.Pod, logOptions).Stream(ctx)
+}
+
+// item is a line or an error of a source sent to the merger, done marks the end of the source.
+type item struct {
+	source   Source
+	line     Line
+	err      error
+	done     bool
+	received time.Time
+}
+
+// Stream follows the logs of the sources and calls onLine with the lines of all sources merged in
+// timestamp order, and onError for every source that fails. It returns once every source has ended
+// or the context is done, or when onLine fails.
+func Stream(ctx context.Context, sources []Source, opts Options,
+	onLine func(Line) error, onError func(Source, error)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logOptions := &corev1.PodLogOptions{
+		Follow:       opts.Follow && !opts.Previous,
+		Previous:     opts.Previous,
+		SinceSeconds: opts.SinceSeconds,
+		TailLines:    opts.TailLines,
+		Timestamps:   true,
+	}
+	items := make(chan item)
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			readSource(ctx, source, logOptions, opts.Filter, items)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(items)
+	}()
+
+	ticker := time.NewTicker(reorderWindow / 2)
+	defer ticker.Stop()
+	pending := &lineHeap{}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case it, ok := <-items:
+			if !ok {
+				return pending.flush(time.Time{}, onLine)
+			}
+			if it.err != nil {
+				onError(it.source, it.err)
+				continue
+			}
+			heap.Push(pending, it)
+			if pending.Len() > maxPending {
+				if err := onLine(heap.Pop(pending).(item).line); err != nil {
+					return err
+				}
+			}
+		case now := <-ticker.C:
+			if err := pending.flush(now.Add(-reorderWindow), onLine); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readSource sends the lines of a source matching the filter to the merger.
+func readSource(ctx context.Context, source Source, logOptions *corev1.PodLogOptions, filter *regexp.Regexp, items chan<- item) {
+	send := func(it item) bool {
+		select {
+		case items <- it:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	stream, err := openStream(ctx, source, logOptions)
+	if err != nil {
+		send(item{source: source, err: err})
+		return
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := parseLine(source, scanner.Text())
+		if filter != nil && !filter.MatchString(line.Content) {
+			continue
+		}
+		if !send(item{source: source, line: line, received: time.Now()}) {
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		send(item{source: source, err: err})
+	}
+}
+
+// parseLine splits the RFC3339 timestamp the kubelet prefixes the line with from its content.
+func parseLine(source Source, raw string) Line {
+	line := Line{Cluster: source.Cluster, Pod: source.Pod, Container: source.Container, Content: raw}
+	if timestamp, content, found := strings.Cut(raw, " "); found {
+		if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			line.Timestamp = t
+			line.Content = content
+		}
+	}
+	return line
+}
+
+// lineHeap holds back lines ordered by timestamp.
+type lineHeap []item
+
+func (h lineHeap) Len() int { return len(h) }
+
+func (h lineHeap) Less(i, j int) bool {
+	if h[i].line.Timestamp.Equal(h[j].line.Timestamp) {
+		return h[i].received.Before(h[j].received)
+	}
+	return h[i].line.Timestamp.Before(h[j].line.Timestamp)
+}
+
+func (h lineHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *lineHeap) Push(x any) { *h = append(*h, x.(item)) }
+
+func (h *lineHeap) Pop() any {
+	old := *h
+	it := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return it
+}
+
+// flush emits the oldest lines in timestamp order as long as they were received before the
+// cutoff, every line when the cutoff is zero.
+func (h *lineHeap) flush(cutoff time.Time, onLine func(Line) error) error {
+	for h.Len() > 0 {
+		if !cutoff.IsZero() && (*h)[0].received.After(cutoff) {
+			return nil
+		}
+		if err := onLine(heap.Pop(h).(item).line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+func TestSources(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}},
+	}
+	clusterPods := []topology.ClusterPods{
+		{Cluster: "member1", Pods: []*corev1.Pod{pod}},
+		{Cluster: "member2", Pods: []*corev1.Pod{pod}},
+	}
+	if sources := Sources(clusterPods, ""); len(sources) != 4 {
+		t.Fatalf("expected 4 sources, got %v", sources)
+	}
+	sources := Sources(clusterPods, "app")
+	if len(sources) != 2 || sources[0].Container != "app" || sources[1].Cluster != "member2" {
+		t.Fatalf("expected the app container of both clusters, got %v", sources)
+	}
+}
+
+func TestParseLine(t *testing.T) {
+	source := Source{Cluster: "member1", Pod: "web-1", Container: "app"}
+	line := parseLine(source, "2026-01-02T03:04:05.123456789Z hello world")
+	if line.Content != "hello world" || line.Timestamp.Nanosecond() != 123456789 {
+		t.Fatalf("unexpected line %+v", line)
+	}
+	line = parseLine(source, "no timestamp")
+	if line.Content != "no timestamp" || !line.Timestamp.IsZero() {
+		t.Fatalf("unexpected line %+v", line)
+	}
+}
+
+func TestStream(t *testing.T) {
+	logs := map[string]string{
+		"member1": "2026-01-02T03:04:01Z first\n2026-01-02T03:04:03Z third\n2026-01-02T03:04:05Z debug fifth\n",
+		"member2": "2026-01-02T03:04:02Z second\n2026-01-02T03:04:04Z fourth\n",
+	}
+	original := openStream
+	defer func() { openStream = original }()
+	openStream = func(_ context.Context, source Source, logOptions *corev1.PodLogOptions) (io.ReadCloser, error) {
+		if !logOptions.Timestamps || logOptions.Follow {
+			t.Errorf("unexpected log options %+v", logOptions)
+		}
+		content, ok := logs[source.Cluster]
+		if !ok {
+			return nil, fmt.Errorf("unreachable")
+		}
+		return io.NopCloser(strings.NewReader(content)), nil
+	}
+
+	sources := []Source{{Cluster: "member1", Pod: "a"}, {Cluster: "member2", Pod: "b"}, {Cluster: "member3", Pod: "c"}}
+	var contents []string
+	var failed []string
+	err := Stream(context.TODO(), sources, Options{Follow: true, Previous: true, Filter: regexp.MustCompile("^[a-z]+$")},
+		func(line Line) error {
+			contents = append(contents, line.Content)
+			return nil
+		},
+		func(source Source, _ error) {
+			failed = append(failed, source.Cluster)
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(contents, ",") != "first,second,third,fourth" {
+		t.Fatalf("unexpected lines %v", contents)
+	}
+	if len(failed) != 1 || failed[0] != "member3" {
+		t.Fatalf("expected member3 to fail, got %v", failed)
+	}
+}
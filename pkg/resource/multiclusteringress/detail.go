@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// MultiClusterIngressDetail is a presentation layer view of a Karmada MultiClusterIngress.
+type MultiClusterIngressDetail struct {
+	// Extends list item structure.
+	MultiClusterIngress `json:",inline"`
+
+	Spec networkingv1.IngressSpec `json:"spec"`
+	// ServiceLocations are the clusters each backend Service is located in.
+	ServiceLocations     []networkingv1alpha1.ServiceLocation `json:"serviceLocations"`
+	TrafficBlockClusters []string                             `json:"trafficBlockClusters"`
+	// Warnings point at backend Services that are not located in any cluster.
+	Warnings []string `json:"warnings"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetMultiClusterIngressDetail gets MultiClusterIngress details.
+func GetMultiClusterIngressDetail(client karmadaclientset.Interface, namespace, name string) (*MultiClusterIngressDetail, error) {
+	mci, err := client.NetworkingV1alpha1().MultiClusterIngresses(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	detail := &MultiClusterIngressDetail{
+		MultiClusterIngress:  toMultiClusterIngress(mci),
+		Spec:                 mci.Spec,
+		ServiceLocations:     mci.Status.ServiceLocations,
+		TrafficBlockClusters: mci.Status.TrafficBlockClusters,
+		Warnings:             []string{},
+		Errors:               []error{},
+	}
+
+	located := make(map[string]bool, len(mci.Status.ServiceLocations))
+	for _, location := range mci.Status.ServiceLocations {
+		located[location.Name] = len(location.Clusters) > 0
+	}
+	for _, backend := range backendServices(mci) {
+		if !located[backend] {
+			detail.Warnings = append(detail.Warnings,
+				fmt.Sprintf("backend service %s/%s is not located in any cluster", namespace, backend))
+		}
+	}
+	return detail, nil
+}
+
+// backendServices returns the names of the Services the MultiClusterIngress routes to, in order of
+// appearance.
+func backendServices(mci *networkingv1alpha1.MultiClusterIngress) []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(backend *networkingv1.IngressBackend) {
+		if backend == nil || backend.Service == nil || seen[backend.Service.Name] {
+			return
+		}
+		seen[backend.Service.Name] = true
+		names = append(names, backend.Service.Name)
+	}
+	add(mci.Spec.DefaultBackend)
+	for _, rule := range mci.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for i := range rule.HTTP.Paths {
+			add(&rule.HTTP.Paths[i].Backend)
+		}
+	}
+	return names
+}
+
+// CreateMultiClusterIngress creates a MultiClusterIngress from its YAML content in the namespace.
+func CreateMultiClusterIngress(ctx context.Context, client karmadaclientset.Interface, namespace, content string) (*networkingv1alpha1.MultiClusterIngress, error) {
+	obj := &networkingv1alpha1.MultiClusterIngress{}
+	if err := yaml.UnmarshalStrict([]byte(content), obj); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid MultiClusterIngress: %v", err))
+	}
+	if obj.Namespace != "" && obj.Namespace != namespace {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("MultiClusterIngress namespace %s does not match namespace %s", obj.Namespace, namespace))
+	}
+	obj.Namespace = namespace
+	return client.NetworkingV1alpha1().MultiClusterIngresses(namespace).Create(ctx, obj, metaV1.CreateOptions{})
+}
+
+// UpdateMultiClusterIngress replaces the spec of a MultiClusterIngress with the one of its YAML content, the
+// metadata and status are kept.
+func UpdateMultiClusterIngress(ctx context.Context, client karmadaclientset.Interface, namespace, name, content string) (*networkingv1alpha1.MultiClusterIngress, error) {
+	obj := &networkingv1alpha1.MultiClusterIngress{}
+	if err := yaml.UnmarshalStrict([]byte(content), obj); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid MultiClusterIngress: %v", err))
+	}
+	current, err := client.NetworkingV1alpha1().MultiClusterIngresses(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	current.Spec = obj.Spec
+	return client.NetworkingV1alpha1().MultiClusterIngresses(namespace).Update(ctx, current, metaV1.UpdateOptions{})
+}
+
+// DeleteMultiClusterIngress deletes a MultiClusterIngress.
+func DeleteMultiClusterIngress(ctx context.Context, client karmadaclientset.Interface, namespace, name string) error {
+	return client.NetworkingV1alpha1().MultiClusterIngresses(namespace).Delete(ctx, name, metaV1.DeleteOptions{})
+}
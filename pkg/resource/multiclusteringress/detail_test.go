@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	networkingv1 "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetMultiClusterIngressDetail(t *testing.T) {
+	backend := func(name string) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: name}}
+	}
+	web, api := backend("web"), backend("api")
+	mci := &networkingv1alpha1.MultiClusterIngress{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "demo"},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &web,
+			Rules: []networkingv1.IngressRule{{
+				Host: "demo.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{Path: "/", Backend: web}, {Path: "/api", Backend: api}},
+				}},
+			}},
+		},
+		Status: networkingv1alpha1.MultiClusterIngressStatus{
+			ServiceLocations: []networkingv1alpha1.ServiceLocation{
+				{Name: "web", Clusters: []string{"member1", "member2"}},
+				{Name: "api"},
+			},
+		},
+	}
+	client := karmadafake.NewSimpleClientset(mci)
+
+	detail, err := GetMultiClusterIngressDetail(client, "default", "demo")
+	if err != nil {
+		t.Fatalf("GetMultiClusterIngressDetail() error = %v", err)
+	}
+	if !reflect.DeepEqual(detail.Hosts, []string{"demo.example.com"}) {
+		t.Errorf("GetMultiClusterIngressDetail() hosts = %v, want [demo.example.com]", detail.Hosts)
+	}
+	if want := []string{"backend service default/api is not located in any cluster"}; !reflect.DeepEqual(detail.Warnings, want) {
+		t.Errorf("GetMultiClusterIngressDetail() warnings = %v, want %v", detail.Warnings, want)
+	}
+}
+
+func TestCreateUpdateAndDeleteMultiClusterIngress(t *testing.T) {
+	client := karmadafake.NewSimpleClientset()
+	content := `apiVersion: networking.karmada.io/v1alpha1
+kind: MultiClusterIngress
+metadata:
+  name: demo
+spec:
+  defaultBackend:
+    service:
+      name: web
+      port:
+        number: 80
+`
+	if _, err := CreateMultiClusterIngress(context.TODO(), client, "default", content+"  unknown: true\n"); err == nil {
+		t.Errorf("CreateMultiClusterIngress() with an unknown field error = nil, want error")
+	}
+	created, err := CreateMultiClusterIngress(context.TODO(), client, "default", content)
+	if err != nil {
+		t.Fatalf("CreateMultiClusterIngress() error = %v", err)
+	}
+	if created.Namespace != "default" {
+		t.Errorf("CreateMultiClusterIngress() namespace = %q, want default", created.Namespace)
+	}
+
+	updated, err := UpdateMultiClusterIngress(context.TODO(), client, "default", "demo",
+		"spec:\n  defaultBackend:\n    service:\n      name: api\n      port:\n        number: 8080\n")
+	if err != nil {
+		t.Fatalf("UpdateMultiClusterIngress() error = %v", err)
+	}
+	if backends := backendServices(updated); !reflect.DeepEqual(backends, []string{"api"}) {
+		t.Errorf("UpdateMultiClusterIngress() backends = %v, want [api]", backends)
+	}
+
+	if err := DeleteMultiClusterIngress(context.TODO(), client, "default", "demo"); err != nil {
+		t.Fatalf("DeleteMultiClusterIngress() error = %v", err)
+	}
+	if _, err := client.NetworkingV1alpha1().MultiClusterIngresses("default").Get(context.TODO(), "demo", metaV1.GetOptions{}); err == nil {
+		t.Errorf("MultiClusterIngress still exists after DeleteMultiClusterIngress()")
+	}
+}
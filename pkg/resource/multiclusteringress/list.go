@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// MultiClusterIngressList contains a list of MultiClusterIngresses in the karmada control-plane.
+type MultiClusterIngressList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of MultiClusterIngresses.
+	MultiClusterIngresses []MultiClusterIngress `json:"multiClusterIngresses"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// MultiClusterIngress contains information about a single MultiClusterIngress.
+type MultiClusterIngress struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// External endpoints of this ingress.
+	Endpoints []common.Endpoint `json:"endpoints"`
+	Hosts     []string          `json:"hosts"`
+}
+
+// GetMultiClusterIngressList returns a list of all MultiClusterIngresses in the Karmada control-plane.
+func GetMultiClusterIngressList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*MultiClusterIngressList, error) {
+	mciList, err := client.NetworkingV1alpha1().MultiClusterIngresses(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toMultiClusterIngressList(mciList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toMultiClusterIngressList(mciList []networkingv1alpha1.MultiClusterIngress, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *MultiClusterIngressList {
+	result := &MultiClusterIngressList{
+		MultiClusterIngresses: make([]MultiClusterIngress, 0),
+		ListMeta:              types.ListMeta{TotalItems: len(mciList)},
+	}
+	mciCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(mciList), dsQuery)
+	mciList = fromCells(mciCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	result.Errors = nonCriticalErrors
+
+	for i := range mciList {
+		result.MultiClusterIngresses = append(result.MultiClusterIngresses, toMultiClusterIngress(&mciList[i]))
+	}
+	return result
+}
+
+func toMultiClusterIngress(mci *networkingv1alpha1.MultiClusterIngress) MultiClusterIngress {
+	return MultiClusterIngress{
+		ObjectMeta: types.NewObjectMeta(mci.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindMultiClusterIngress),
+		Endpoints:  getEndpoints(mci),
+		Hosts:      getHosts(mci),
+	}
+}
+
+func getEndpoints(mci *networkingv1alpha1.MultiClusterIngress) []common.Endpoint {
+	endpoints := make([]common.Endpoint, 0)
+	for _, status := range mci.Status.LoadBalancer.Ingress {
+		endpoint := common.Endpoint{}
+		if status.Hostname != "" {
+			endpoint.Host = status.Hostname
+		} else if status.IP != "" {
+			endpoint.Host = status.IP
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+func getHosts(mci *networkingv1alpha1.MultiClusterIngress) []string {
+	hosts := make([]string, 0)
+	set := make(map[string]struct{})
+	for _, rule := range mci.Spec.Rules {
+		if _, exists := set[rule.Host]; !exists && len(rule.Host) > 0 {
+			hosts = append(hosts, rule.Host)
+		}
+		set[rule.Host] = struct{}{}
+	}
+	return hosts
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// MultiClusterServiceCell represents a MultiClusterService that implements the DataCell interface.
+type MultiClusterServiceCell networkingv1alpha1.MultiClusterService
+
+// GetProperty returns a comparable value for a specified property name.
+func (c MultiClusterServiceCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []networkingv1alpha1.MultiClusterService) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = MultiClusterServiceCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []networkingv1alpha1.MultiClusterService {
+	std := make([]networkingv1alpha1.MultiClusterService, len(cells))
+	for i := range std {
+		std[i] = networkingv1alpha1.MultiClusterService(cells[i].(MultiClusterServiceCell))
+	}
+	return std
+}
+
+// providerClusters returns the clusters that provide the backend of the MultiClusterService, nil
+// when all clusters do.
+func providerClusters(mcs *networkingv1alpha1.MultiClusterService) []string {
+	if len(mcs.Spec.ProviderClusters) > 0 {
+		return selectorNames(mcs.Spec.ProviderClusters)
+	}
+	return mcs.Spec.ServiceProvisionClusters
+}
+
+// consumerClusters returns the clusters the MultiClusterService is exposed to, nil when it is
+// exposed to all clusters.
+func consumerClusters(mcs *networkingv1alpha1.MultiClusterService) []string {
+	if len(mcs.Spec.ConsumerClusters) > 0 {
+		return selectorNames(mcs.Spec.ConsumerClusters)
+	}
+	if len(mcs.Spec.ServiceConsumptionClusters) > 0 {
+		return mcs.Spec.ServiceConsumptionClusters
+	}
+	return mcs.Spec.Range.ClusterNames
+}
+
+func selectorNames(selectors []networkingv1alpha1.ClusterSelector) []string {
+	names := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		names = append(names, selector.Name)
+	}
+	return names
+}
+
+// isCrossCluster reports whether the MultiClusterService syncs endpoints between clusters.
+func isCrossCluster(mcs *networkingv1alpha1.MultiClusterService) bool {
+	for _, t := range mcs.Spec.Types {
+		if t == networkingv1alpha1.ExposureTypeCrossCluster {
+			return true
+		}
+	}
+	return false
+}
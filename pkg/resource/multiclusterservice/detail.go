@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
+// memberClientFor reaches the member clusters, it is replaced in tests.
+var memberClientFor = client.InClusterClientForMemberCluster
+
+// EndpointSlice is an EndpointSlice of the service in a consumer cluster.
+type EndpointSlice struct {
+	Name string `json:"name"`
+	// ProviderCluster is the cluster the endpoints were collected from, empty for the endpoints of
+	// the consumer cluster itself.
+	ProviderCluster string                     `json:"providerCluster"`
+	AddressType     discoveryv1.AddressType    `json:"addressType"`
+	Endpoints       int                        `json:"endpoints"`
+	ReadyEndpoints  int                        `json:"readyEndpoints"`
+	Ports           []discoveryv1.EndpointPort `json:"ports"`
+}
+
+// ConsumerCluster is a cluster the service is exposed to, with the EndpointSlices found there.
+type ConsumerCluster struct {
+	Cluster        string          `json:"cluster"`
+	EndpointSlices []EndpointSlice `json:"endpointSlices"`
+	ReadyEndpoints int             `json:"readyEndpoints"`
+}
+
+// MultiClusterServiceDetail is a presentation layer view of a Karmada MultiClusterService.
+type MultiClusterServiceDetail struct {
+	// Extends list item structure.
+	MultiClusterService `json:",inline"`
+
+	// ProviderClusters and ConsumerClusters of the detail are resolved to the joined clusters when
+	// the MultiClusterService selects all of them.
+	ConsumerStatuses []ConsumerCluster  `json:"consumerStatuses"`
+	Conditions       []metaV1.Condition `json:"conditions"`
+	// Warnings point at consumer clusters without any ready endpoint of the service.
+	Warnings []string `json:"warnings"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetMultiClusterServiceDetail gets MultiClusterService details, the EndpointSlices of the
+// consumer clusters are read from the member clusters.
+func GetMultiClusterServiceDetail(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, name string) (*MultiClusterServiceDetail, error) {
+	mcs, err := karmadaClient.NetworkingV1alpha1().MultiClusterServices(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	detail := &MultiClusterServiceDetail{
+		MultiClusterService: toMultiClusterService(mcs),
+		ConsumerStatuses:    []ConsumerCluster{},
+		Conditions:          mcs.Status.Conditions,
+		Warnings:            []string{},
+		Errors:              []error{},
+	}
+
+	if len(detail.ProviderClusters) == 0 || len(detail.ConsumerClusters) == 0 {
+		clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, helpers.ListEverything)
+		if err != nil {
+			return nil, err
+		}
+		all := make([]string, 0, len(clusters.Items))
+		for _, cluster := range clusters.Items {
+			all = append(all, cluster.Name)
+		}
+		if len(detail.ProviderClusters) == 0 {
+			detail.ProviderClusters = all
+		}
+		if len(detail.ConsumerClusters) == 0 {
+			detail.ConsumerClusters = all
+		}
+	}
+
+	// Only CrossCluster services get their endpoints dispatched to the consumers.
+	if !isCrossCluster(mcs) {
+		return detail, nil
+	}
+	for _, cluster := range detail.ConsumerClusters {
+		consumer, err := getConsumerCluster(ctx, cluster, namespace, name)
+		if err != nil {
+			detail.Errors = append(detail.Errors, err)
+			continue
+		}
+		if consumer.ReadyEndpoints == 0 {
+			detail.Warnings = append(detail.Warnings,
+				fmt.Sprintf("consumer cluster %s has no ready endpoints for service %s/%s", cluster, namespace, name))
+		}
+		detail.ConsumerStatuses = append(detail.ConsumerStatuses, *consumer)
+	}
+	return detail, nil
+}
+
+func getConsumerCluster(ctx context.Context, cluster, namespace, name string) (*ConsumerCluster, error) {
+	memberClient := memberClientFor(cluster)
+	if memberClient == nil {
+		return nil, fmt.Errorf("cluster %s: no client", cluster)
+	}
+	slices, err := memberClient.DiscoveryV1().EndpointSlices(namespace).List(ctx, metaV1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: name}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cluster %s: %w", cluster, err)
+	}
+
+	consumer := &ConsumerCluster{Cluster: cluster, EndpointSlices: make([]EndpointSlice, 0, len(slices.Items))}
+	for _, slice := range slices.Items {
+		eps := EndpointSlice{
+			Name:            slice.Name,
+			ProviderCluster: slice.Annotations[util.EndpointSliceProvisionClusterAnnotation],
+			AddressType:     slice.AddressType,
+			Endpoints:       len(slice.Endpoints),
+			Ports:           slice.Ports,
+		}
+		for _, endpoint := range slice.Endpoints {
+			// An unknown readiness is interpreted as ready.
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				eps.ReadyEndpoints++
+			}
+		}
+		consumer.ReadyEndpoints += eps.ReadyEndpoints
+		consumer.EndpointSlices = append(consumer.EndpointSlices, eps)
+	}
+	return consumer, nil
+}
+
+// CreateMultiClusterService creates a MultiClusterService from its YAML content in the namespace.
+func CreateMultiClusterService(ctx context.Context, client karmadaclientset.Interface, namespace, content string) (*networkingv1alpha1.MultiClusterService, error) {
+	obj := &networkingv1alpha1.MultiClusterService{}
+	if err := yaml.UnmarshalStrict([]byte(content), obj); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid MultiClusterService: %v", err))
+	}
+	if obj.Namespace != "" && obj.Namespace != namespace {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("MultiClusterService namespace %s does not match namespace %s", obj.Namespace, namespace))
+	}
+	obj.Namespace = namespace
+	return client.NetworkingV1alpha1().MultiClusterServices(namespace).Create(ctx, obj, metaV1.CreateOptions{})
+}
+
+// UpdateMultiClusterService replaces the spec of a MultiClusterService with the one of its YAML content, the
+// metadata and status are kept.
+func UpdateMultiClusterService(ctx context.Context, client karmadaclientset.Interface, namespace, name, content string) (*networkingv1alpha1.MultiClusterService, error) {
+	obj := &networkingv1alpha1.MultiClusterService{}
+	if err := yaml.UnmarshalStrict([]byte(content), obj); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid MultiClusterService: %v", err))
+	}
+	current, err := client.NetworkingV1alpha1().MultiClusterServices(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	current.Spec = obj.Spec
+	return client.NetworkingV1alpha1().MultiClusterServices(namespace).Update(ctx, current, metaV1.UpdateOptions{})
+}
+
+// DeleteMultiClusterService deletes a MultiClusterService.
+func DeleteMultiClusterService(ctx context.Context, client karmadaclientset.Interface, namespace, name string) error {
+	return client.NetworkingV1alpha1().MultiClusterServices(namespace).Delete(ctx, name, metaV1.DeleteOptions{})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetMultiClusterServiceDetail(t *testing.T) {
+	mcs := &networkingv1alpha1.MultiClusterService{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Spec: networkingv1alpha1.MultiClusterServiceSpec{
+			Types:            []networkingv1alpha1.ExposureType{networkingv1alpha1.ExposureTypeCrossCluster},
+			ProviderClusters: []networkingv1alpha1.ClusterSelector{{Name: "member1"}},
+		},
+	}
+	karmadaClient := karmadafake.NewSimpleClientset(mcs,
+		&clusterv1alpha1.Cluster{ObjectMeta: metaV1.ObjectMeta{Name: "member1"}},
+		&clusterv1alpha1.Cluster{ObjectMeta: metaV1.ObjectMeta{Name: "member2"}},
+		&clusterv1alpha1.Cluster{ObjectMeta: metaV1.ObjectMeta{Name: "member3"}},
+	)
+	members := map[string]kubeclient.Interface{
+		"member1": fake.NewSimpleClientset(&discoveryv1.EndpointSlice{
+			ObjectMeta: metaV1.ObjectMeta{
+				Namespace: "default", Name: "nginx-abc",
+				Labels: map[string]string{discoveryv1.LabelServiceName: "nginx"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.1"}},
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+			},
+		}),
+		"member2": fake.NewSimpleClientset(&discoveryv1.EndpointSlice{
+			ObjectMeta: metaV1.ObjectMeta{
+				Namespace: "default", Name: "imported-member1-nginx-abc",
+				Labels:      map[string]string{discoveryv1.LabelServiceName: "nginx"},
+				Annotations: map[string]string{util.EndpointSliceProvisionClusterAnnotation: "member1"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+		}),
+	}
+	defer func(old func(string) kubeclient.Interface) { memberClientFor = old }(memberClientFor)
+	memberClientFor = func(cluster string) kubeclient.Interface {
+		if c, ok := members[cluster]; ok {
+			return c
+		}
+		return nil
+	}
+
+	detail, err := GetMultiClusterServiceDetail(context.TODO(), karmadaClient, "default", "nginx")
+	if err != nil {
+		t.Fatalf("GetMultiClusterServiceDetail() error = %v", err)
+	}
+	if !reflect.DeepEqual(detail.ProviderClusters, []string{"member1"}) {
+		t.Errorf("GetMultiClusterServiceDetail() providers = %v, want [member1]", detail.ProviderClusters)
+	}
+	if !reflect.DeepEqual(detail.ConsumerClusters, []string{"member1", "member2", "member3"}) {
+		t.Errorf("GetMultiClusterServiceDetail() consumers = %v, want all clusters", detail.ConsumerClusters)
+	}
+	if len(detail.ConsumerStatuses) != 2 {
+		t.Fatalf("GetMultiClusterServiceDetail() consumer statuses = %v, want member1 and member2", detail.ConsumerStatuses)
+	}
+	if got := detail.ConsumerStatuses[0]; got.ReadyEndpoints != 1 || got.EndpointSlices[0].Endpoints != 2 {
+		t.Errorf("GetMultiClusterServiceDetail() member1 = %+v, want 1 of 2 endpoints ready", got)
+	}
+	if got := detail.ConsumerStatuses[1].EndpointSlices[0].ProviderCluster; got != "member1" {
+		t.Errorf("GetMultiClusterServiceDetail() member2 slice provider = %q, want member1", got)
+	}
+	if want := []string{"consumer cluster member2 has no ready endpoints for service default/nginx"}; !reflect.DeepEqual(detail.Warnings, want) {
+		t.Errorf("GetMultiClusterServiceDetail() warnings = %v, want %v", detail.Warnings, want)
+	}
+	if len(detail.Errors) != 1 {
+		t.Errorf("GetMultiClusterServiceDetail() errors = %v, want the unreachable member3", detail.Errors)
+	}
+}
+
+func TestFromService(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+		},
+		&corev1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "external"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: "example.com"},
+		},
+	)
+	karmadaClient := karmadafake.NewSimpleClientset()
+
+	if _, err := FromService(context.TODO(), k8sClient, karmadaClient, "default", "external", FromServiceOptions{}); err == nil {
+		t.Errorf("FromService() of an ExternalName service error = nil, want error")
+	}
+
+	preview, err := FromService(context.TODO(), k8sClient, karmadaClient, "default", "nginx", FromServiceOptions{
+		ConsumerClusters: []string{"member2"},
+		DryRun:           true,
+	})
+	if err != nil {
+		t.Fatalf("FromService() dry run error = %v", err)
+	}
+	wantSpec := networkingv1alpha1.MultiClusterServiceSpec{
+		Types:            []networkingv1alpha1.ExposureType{networkingv1alpha1.ExposureTypeCrossCluster},
+		Ports:            []networkingv1alpha1.ExposurePort{{Name: "http", Port: 80}},
+		ConsumerClusters: []networkingv1alpha1.ClusterSelector{{Name: "member2"}},
+	}
+	if !reflect.DeepEqual(preview.Spec, wantSpec) {
+		t.Errorf("FromService() spec = %+v, want %+v", preview.Spec, wantSpec)
+	}
+	if _, err := karmadaClient.NetworkingV1alpha1().MultiClusterServices("default").Get(context.TODO(), "nginx", metaV1.GetOptions{}); err == nil {
+		t.Errorf("FromService() dry run created the MultiClusterService")
+	}
+
+	if _, err := FromService(context.TODO(), k8sClient, karmadaClient, "default", "nginx", FromServiceOptions{}); err != nil {
+		t.Fatalf("FromService() error = %v", err)
+	}
+	if _, err := karmadaClient.NetworkingV1alpha1().MultiClusterServices("default").Get(context.TODO(), "nginx", metaV1.GetOptions{}); err != nil {
+		t.Errorf("FromService() did not create the MultiClusterService: %v", err)
+	}
+}
+
+func TestCreateUpdateAndDeleteMultiClusterService(t *testing.T) {
+	client := karmadafake.NewSimpleClientset()
+	content := `apiVersion: networking.karmada.io/v1alpha1
+kind: MultiClusterService
+metadata:
+  name: nginx
+spec:
+  types:
+  - CrossCluster
+`
+	if _, err := CreateMultiClusterService(context.TODO(), client, "other", content+"  unknown: true\n"); err == nil {
+		t.Errorf("CreateMultiClusterService() with an unknown field error = nil, want error")
+	}
+	if _, err := CreateMultiClusterService(context.TODO(), client, "other", content+"  namespace: default\n"); err == nil {
+		t.Errorf("CreateMultiClusterService() with a mismatched namespace error = nil, want error")
+	}
+	created, err := CreateMultiClusterService(context.TODO(), client, "default", content)
+	if err != nil {
+		t.Fatalf("CreateMultiClusterService() error = %v", err)
+	}
+	if created.Namespace != "default" {
+		t.Errorf("CreateMultiClusterService() namespace = %q, want default", created.Namespace)
+	}
+
+	updated, err := UpdateMultiClusterService(context.TODO(), client, "default", "nginx",
+		"spec:\n  types:\n  - CrossCluster\n  consumerClusters:\n  - name: member1\n")
+	if err != nil {
+		t.Fatalf("UpdateMultiClusterService() error = %v", err)
+	}
+	if len(updated.Spec.ConsumerClusters) != 1 || updated.Spec.ConsumerClusters[0].Name != "member1" {
+		t.Errorf("UpdateMultiClusterService() consumer clusters = %v, want [member1]", updated.Spec.ConsumerClusters)
+	}
+
+	if err := DeleteMultiClusterService(context.TODO(), client, "default", "nginx"); err != nil {
+		t.Fatalf("DeleteMultiClusterService() error = %v", err)
+	}
+	if _, err := client.NetworkingV1alpha1().MultiClusterServices("default").Get(context.TODO(), "nginx", metaV1.GetOptions{}); err == nil {
+		t.Errorf("MultiClusterService still exists after DeleteMultiClusterService()")
+	}
+}
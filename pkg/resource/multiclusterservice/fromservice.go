@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+)
+
+// FromServiceOptions tells how to expose a Service as a MultiClusterService.
+type FromServiceOptions struct {
+	// Types defaults to CrossCluster.
+	Types []networkingv1alpha1.ExposureType
+	// ProviderClusters and ConsumerClusters default to all clusters.
+	ProviderClusters []string
+	ConsumerClusters []string
+	// DryRun returns the MultiClusterService without creating it.
+	DryRun bool
+}
+
+// FromService creates a MultiClusterService exposing the ports of a Service of the control plane,
+// under the name of the Service.
+func FromService(ctx context.Context, k8sClient kubeclient.Interface, karmadaClient karmadaclientset.Interface, namespace, name string, opts FromServiceOptions) (*networkingv1alpha1.MultiClusterService, error) {
+	svc, err := k8sClient.CoreV1().Services(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("service %s/%s of type ExternalName can not be exposed across clusters", namespace, name))
+	}
+
+	mcs := &networkingv1alpha1.MultiClusterService{
+		TypeMeta: metaV1.TypeMeta{
+			APIVersion: networkingv1alpha1.SchemeGroupVersion.String(),
+			Kind:       networkingv1alpha1.ResourceKindMultiClusterService,
+		},
+		ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: networkingv1alpha1.MultiClusterServiceSpec{
+			Types:            opts.Types,
+			ProviderClusters: toSelectors(opts.ProviderClusters),
+			ConsumerClusters: toSelectors(opts.ConsumerClusters),
+		},
+	}
+	if len(mcs.Spec.Types) == 0 {
+		mcs.Spec.Types = []networkingv1alpha1.ExposureType{networkingv1alpha1.ExposureTypeCrossCluster}
+	}
+	for _, port := range svc.Spec.Ports {
+		mcs.Spec.Ports = append(mcs.Spec.Ports, networkingv1alpha1.ExposurePort{Name: port.Name, Port: port.Port})
+	}
+
+	if opts.DryRun {
+		return mcs, nil
+	}
+	return karmadaClient.NetworkingV1alpha1().MultiClusterServices(namespace).Create(ctx, mcs, metaV1.CreateOptions{})
+}
+
+func toSelectors(clusters []string) []networkingv1alpha1.ClusterSelector {
+	if len(clusters) == 0 {
+		return nil
+	}
+	selectors := make([]networkingv1alpha1.ClusterSelector, 0, len(clusters))
+	for _, cluster := range clusters {
+		selectors = append(selectors, networkingv1alpha1.ClusterSelector{Name: cluster})
+	}
+	return selectors
+}
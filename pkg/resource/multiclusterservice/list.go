@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// MultiClusterServiceList contains a list of MultiClusterServices in the karmada control-plane.
+type MultiClusterServiceList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of MultiClusterServices.
+	MultiClusterServices []MultiClusterService `json:"multiClusterServices"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// MultiClusterService contains information about a single MultiClusterService.
+type MultiClusterService struct {
+	ObjectMeta types.ObjectMeta                  `json:"objectMeta"`
+	TypeMeta   types.TypeMeta                    `json:"typeMeta"`
+	Types      []networkingv1alpha1.ExposureType `json:"types"`
+	Ports      []networkingv1alpha1.ExposurePort `json:"ports"`
+	// ProviderClusters and ConsumerClusters are empty when all clusters provide or consume the
+	// service.
+	ProviderClusters []string `json:"providerClusters"`
+	ConsumerClusters []string `json:"consumerClusters"`
+}
+
+// GetMultiClusterServiceList returns a list of all MultiClusterServices in the Karmada control-plane.
+func GetMultiClusterServiceList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*MultiClusterServiceList, error) {
+	mcsList, err := client.NetworkingV1alpha1().MultiClusterServices(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toMultiClusterServiceList(mcsList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toMultiClusterServiceList(mcsList []networkingv1alpha1.MultiClusterService, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *MultiClusterServiceList {
+	result := &MultiClusterServiceList{
+		MultiClusterServices: make([]MultiClusterService, 0),
+		ListMeta:             types.ListMeta{TotalItems: len(mcsList)},
+	}
+	mcsCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(mcsList), dsQuery)
+	mcsList = fromCells(mcsCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	result.Errors = nonCriticalErrors
+
+	for i := range mcsList {
+		result.MultiClusterServices = append(result.MultiClusterServices, toMultiClusterService(&mcsList[i]))
+	}
+	return result
+}
+
+func toMultiClusterService(mcs *networkingv1alpha1.MultiClusterService) MultiClusterService {
+	return MultiClusterService{
+		ObjectMeta:       types.NewObjectMeta(mcs.ObjectMeta),
+		TypeMeta:         types.NewTypeMeta(types.ResourceKindMultiClusterService),
+		Types:            mcs.Spec.Types,
+		Ports:            mcs.Spec.Ports,
+		ProviderClusters: providerClusters(mcs),
+		ConsumerClusters: consumerClusters(mcs),
+	}
+}
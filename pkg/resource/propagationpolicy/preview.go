@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"context"
+	"sort"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/tainttoleration"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/informer"
+)
+
+// BindingPreview describes how a single binding would be affected by a placement change.
+type BindingPreview struct {
+	Name      string                       `json:"name"`
+	Namespace string                       `json:"namespace,omitempty"`
+	Resource  workv1alpha2.ObjectReference `json:"resource"`
+	// CurrentClusters are the clusters the binding is scheduled to right now.
+	CurrentClusters []string `json:"currentClusters"`
+	// RemovedClusters are current clusters that no longer pass the new placement.
+	RemovedClusters []string `json:"removedClusters"`
+	// WouldMove is true when at least one current cluster would be dropped.
+	WouldMove bool `json:"wouldMove"`
+}
+
+// MatchPreview is the dry-run result of applying a placement to the bindings of a policy.
+type MatchPreview struct {
+	// EligibleClusters are the clusters that pass the cluster affinity and taint filters of the placement.
+	EligibleClusters []string         `json:"eligibleClusters"`
+	Bindings         []BindingPreview `json:"bindings"`
+	// AffectedBindings is the number of bindings that would move.
+	AffectedBindings int `json:"affectedBindings"`
+}
+
+// GetMatchPreview evaluates placement against every cluster and every binding created from the
+// policy identified by permanentIDLabel=permanentID, without changing anything.
+func GetMatchPreview(ctx context.Context, karmadaClient karmadaclientset.Interface,
+	permanentIDLabel, permanentID string, placement policyv1alpha1.Placement) (*MatchPreview, error) {
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &MatchPreview{
+		EligibleClusters: make([]string, 0),
+		Bindings:         make([]BindingPreview, 0),
+	}
+	for i := range clusters.Items {
+		if placementFits(ctx, &workv1alpha2.ResourceBindingSpec{Placement: &placement}, &workv1alpha2.ResourceBindingStatus{}, &clusters.Items[i]) {
+			preview.EligibleClusters = append(preview.EligibleClusters, clusters.Items[i].Name)
+		}
+	}
+	if permanentID == "" {
+		return preview, nil
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{permanentIDLabel: permanentID})
+	items, err := informer.ResourceBindingIndexer().ByIndex(informer.ResourceBindingByPolicyID, permanentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		rb := item.(*workv1alpha2.ResourceBinding)
+		if !selector.Matches(labels.Set(rb.Labels)) {
+			continue
+		}
+		preview.Bindings = append(preview.Bindings, previewBinding(ctx, rb.Name, rb.Namespace, &rb.Spec, &rb.Status, placement, clusters.Items))
+	}
+
+	// ClusterPropagationPolicies may also select cluster-scoped resources.
+	crbs, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	for i := range crbs.Items {
+		crb := &crbs.Items[i]
+		preview.Bindings = append(preview.Bindings, previewBinding(ctx, crb.Name, "", &crb.Spec, &crb.Status, placement, clusters.Items))
+	}
+
+	for _, binding := range preview.Bindings {
+		if binding.WouldMove {
+			preview.AffectedBindings++
+		}
+	}
+	return preview, nil
+}
+
+func previewBinding(ctx context.Context, name, namespace string, spec *workv1alpha2.ResourceBindingSpec,
+	status *workv1alpha2.ResourceBindingStatus, placement policyv1alpha1.Placement, clusters []clusterv1alpha1.Cluster) BindingPreview {
+	draft := spec.DeepCopy()
+	draft.Placement = &placement
+	draftStatus := status.DeepCopy()
+	// Without an observed affinity the scheduler starts over from the first affinity term.
+	if len(placement.ClusterAffinities) > 0 && !hasAffinityTerm(placement, draftStatus.SchedulerObservedAffinityName) {
+		draftStatus.SchedulerObservedAffinityName = placement.ClusterAffinities[0].AffinityName
+	}
+
+	eligible := sets.New[string]()
+	for i := range clusters {
+		if placementFits(ctx, draft, draftStatus, &clusters[i]) {
+			eligible.Insert(clusters[i].Name)
+		}
+	}
+
+	result := BindingPreview{
+		Name:            name,
+		Namespace:       namespace,
+		Resource:        spec.Resource,
+		CurrentClusters: make([]string, 0, len(spec.Clusters)),
+		RemovedClusters: make([]string, 0),
+	}
+	for _, target := range spec.Clusters {
+		result.CurrentClusters = append(result.CurrentClusters, target.Name)
+		if !eligible.Has(target.Name) {
+			result.RemovedClusters = append(result.RemovedClusters, target.Name)
+		}
+	}
+	sort.Strings(result.CurrentClusters)
+	sort.Strings(result.RemovedClusters)
+	result.WouldMove = len(result.RemovedClusters) > 0
+	return result
+}
+
+func hasAffinityTerm(placement policyv1alpha1.Placement, name string) bool {
+	for _, term := range placement.ClusterAffinities {
+		if term.AffinityName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// placementFits runs the scheduler's cluster affinity and taint toleration filters against cluster.
+func placementFits(ctx context.Context, spec *workv1alpha2.ResourceBindingSpec,
+	status *workv1alpha2.ResourceBindingStatus, cluster *clusterv1alpha1.Cluster) bool {
+	filters := []framework.FilterPlugin{&clusteraffinity.ClusterAffinity{}, &tainttoleration.TaintToleration{}}
+	for _, filter := range filters {
+		if !filter.Filter(ctx, spec, status, cluster).IsSuccess() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package raw lists and inspects resources of any kind, based on the tables the apiserver prints.
+package raw
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// The code below allows to perform complex data section on []metav1.TableRow
+
+// RowCell wraps a table row and the metadata of its object for data selection.
+type RowCell struct {
+	Row        metav1.TableRow
+	ObjectMeta metav1.ObjectMeta
+}
+
+// GetProperty returns a property.
+func (c RowCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []RowCell) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = std[i]
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []RowCell {
+	std := make([]RowCell, len(cells))
+	for i := range std {
+		std[i] = cells[i].(RowCell)
+	}
+	return std
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raw
+
+import (
+	"encoding/json"
+	"log"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// Resource is a resource of any kind, as a row of the table the apiserver prints.
+type Resource struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Cells are the values of the columns, in the order of ResourceList.Columns.
+	Cells []interface{} `json:"cells"`
+}
+
+// ResourceList contains a list of resources of a single kind.
+type ResourceList struct {
+	ListMeta types.ListMeta                 `json:"listMeta"`
+	Columns  []metav1.TableColumnDefinition `json:"columns"`
+	Items    []Resource                     `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceList returns the resources of the given kind, kind is the lower case kind or, for custom
+// resources, <plural>.<group>.
+func GetResourceList(verber client.ResourceVerber, kind string, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ResourceList, error) {
+	log.Printf("Getting list of %s in the namespace %s", kind, nsQuery.ToRequestParam())
+	table, err := verber.Table(kind, nsQuery.ToRequestParam())
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	if table == nil {
+		table = &metav1.Table{}
+	}
+	return toResourceList(kind, table, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceList(kind string, table *metav1.Table, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ResourceList {
+	result := &ResourceList{
+		Columns:  table.ColumnDefinitions,
+		Items:    make([]Resource, 0),
+		ListMeta: types.ListMeta{TotalItems: len(table.Rows)},
+		Errors:   nonCriticalErrors,
+	}
+	if result.Columns == nil {
+		result.Columns = make([]metav1.TableColumnDefinition, 0)
+	}
+
+	rows := make([]RowCell, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		// the apiserver embeds the metadata of the objects in the rows by default
+		partial := metav1.PartialObjectMetadata{}
+		if row.Object.Raw != nil {
+			if err := json.Unmarshal(row.Object.Raw, &partial); err != nil {
+				log.Printf("Couldn't read the metadata of a %s row: %s\n", kind, err)
+			}
+		}
+		rows = append(rows, RowCell{Row: row, ObjectMeta: partial.ObjectMeta})
+	}
+
+	rowCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(rows), dsQuery)
+	rows = fromCells(rowCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, row := range rows {
+		result.Items = append(result.Items, Resource{
+			ObjectMeta: types.NewObjectMeta(row.ObjectMeta),
+			TypeMeta:   types.NewTypeMeta(types.ResourceKind(kind)),
+			Cells:      row.Row.Cells,
+		})
+	}
+	return result
+}
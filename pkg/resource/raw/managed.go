@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raw
+
+import (
+	"fmt"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WorkReference names the Work that propagates a member cluster object.
+type WorkReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ManagedByWork returns the Work the member cluster object was propagated by, nil for objects that
+// were not created by karmada.
+func ManagedByWork(obj runtime.Object) *WorkReference {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil
+	}
+	annotations := accessor.GetAnnotations()
+	name := annotations[workv1alpha2.WorkNameAnnotation]
+	if name == "" {
+		return nil
+	}
+	return &WorkReference{Namespace: annotations[workv1alpha2.WorkNamespaceAnnotation], Name: name}
+}
+
+// ManagedWarning explains that changes to an object propagated by karmada do not last.
+func ManagedWarning(work *WorkReference) string {
+	return fmt.Sprintf("this object is managed by karmada Work %s/%s, direct changes will be overwritten by karmada "+
+		"and a deleted object will be recreated, change the resource template in the control plane instead", work.Namespace, work.Name)
+}
+
+// MutationResult is the outcome of a change to a member cluster object.
+type MutationResult struct {
+	// ManagedBy is the Work that propagates the object, if any.
+	ManagedBy *WorkReference `json:"managedBy,omitempty"`
+	Warnings  []string       `json:"warnings"`
+}
+
+// NewMutationResult returns the result of a change to the given member cluster object.
+func NewMutationResult(obj runtime.Object) *MutationResult {
+	result := &MutationResult{ManagedBy: ManagedByWork(obj), Warnings: make([]string, 0)}
+	if result.ManagedBy != nil {
+		result.Warnings = append(result.Warnings, ManagedWarning(result.ManagedBy))
+	}
+	return result
+}
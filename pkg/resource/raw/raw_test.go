@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raw
+
+import (
+	"testing"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+func TestToResourceList(t *testing.T) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Data"}},
+		Rows: []metav1.TableRow{
+			{Cells: []interface{}{"b", int64(2)}, Object: runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"b","namespace":"default"}}`)}},
+			{Cells: []interface{}{"a", int64(1)}, Object: runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"a","namespace":"default"}}`)}},
+		},
+	}
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination,
+		dataselect.NewSortQuery([]string{"a", string(dataselect.NameProperty)}), dataselect.NoFilter)
+	result := toResourceList("configmap", table, nil, dsQuery)
+	if result.ListMeta.TotalItems != 2 || len(result.Columns) != 2 {
+		t.Fatalf("unexpected list: %+v", result)
+	}
+	if result.Items[0].ObjectMeta.Name != "a" || result.Items[0].Cells[1] != int64(1) {
+		t.Fatalf("expected rows sorted by name with their cells, got %+v", result.Items)
+	}
+	if result.Items[0].TypeMeta.Kind != "configmap" {
+		t.Fatalf("unexpected type meta: %+v", result.Items[0].TypeMeta)
+	}
+}
+
+func TestNewMutationResult(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAnnotations(map[string]string{
+		workv1alpha2.WorkNamespaceAnnotation: "karmada-es-member1",
+		workv1alpha2.WorkNameAnnotation:      "nginx-687f7fb96f",
+	})
+	result := NewMutationResult(obj)
+	if result.ManagedBy == nil || result.ManagedBy.Name != "nginx-687f7fb96f" || len(result.Warnings) != 1 {
+		t.Fatalf("expected a warning for an object managed by a Work, got %+v", result)
+	}
+	if result = NewMutationResult(&unstructured.Unstructured{}); result.ManagedBy != nil || len(result.Warnings) != 0 {
+		t.Fatalf("expected no warning for an object not managed by karmada, got %+v", result)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"context"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/resource/work"
+)
+
+// ResourceBindingDetail is a presentation layer view of Karmada ResourceBinding resource, with the Works created for it.
+type ResourceBindingDetail struct {
+	// Extends list item structure.
+	ResourceBinding `json:",inline"`
+
+	Placement                   *policyv1alpha1.Placement           `json:"placement"`
+	SchedulerName               string                              `json:"schedulerName"`
+	LastScheduledTime           *metav1.Time                        `json:"lastScheduledTime"`
+	SchedulerObservedGeneration int64                               `json:"schedulerObservedGeneration"`
+	AggregatedStatus            []workv1alpha2.AggregatedStatusItem `json:"aggregatedStatus"`
+	GracefulEvictionTasks       []workv1alpha2.GracefulEvictionTask `json:"gracefulEvictionTasks"`
+	Works                       []work.Work                         `json:"works"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceBindingDetail gets ResourceBinding details.
+func GetResourceBindingDetail(client karmadaclientset.Interface, namespace, name string) (*ResourceBindingDetail, error) {
+	ctx := context.TODO()
+	binding, err := client.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &ResourceBindingDetail{
+		ResourceBinding:             toResourceBinding(binding),
+		Placement:                   binding.Spec.Placement,
+		SchedulerName:               binding.Spec.SchedulerName,
+		LastScheduledTime:           binding.Status.LastScheduledTime,
+		SchedulerObservedGeneration: binding.Status.SchedulerObservedGeneration,
+		AggregatedStatus:            binding.Status.AggregatedStatus,
+		GracefulEvictionTasks:       binding.Spec.GracefulEvictionTasks,
+		Errors:                      []error{},
+	}
+	works, err := work.GetBindingWorks(ctx, client, workv1alpha2.ResourceBindingPermanentIDLabel,
+		binding.Labels[workv1alpha2.ResourceBindingPermanentIDLabel])
+	if err != nil {
+		detail.Errors = append(detail.Errors, err)
+		works = []work.Work{}
+	}
+	detail.Works = works
+	return detail, nil
+}
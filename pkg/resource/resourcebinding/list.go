@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"context"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// ResourceBindingList contains a list of ResourceBindings in the karmada control-plane.
+type ResourceBindingList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ResourceBindings.
+	ResourceBindings []ResourceBinding `json:"resourceBindings"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResourceBinding contains information about a single ResourceBinding.
+type ResourceBinding struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Resource is the resource template the binding was created for.
+	Resource workv1alpha2.ObjectReference `json:"resource"`
+	// Policy is the PropagationPolicy (namespace/name) or ClusterPropagationPolicy (name) of the binding.
+	Policy     string                       `json:"policy"`
+	Replicas   int32                        `json:"replicas"`
+	Clusters   []workv1alpha2.TargetCluster `json:"clusters"`
+	Conditions []metav1.Condition           `json:"conditions"`
+}
+
+// GetResourceBindingList returns a list of all ResourceBindings in the karmada control-plane.
+func GetResourceBindingList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ResourceBindingList, error) {
+	bindings, err := client.WorkV1alpha2().ResourceBindings(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toResourceBindingList(bindings.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceBindingList(bindings []workv1alpha2.ResourceBinding, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ResourceBindingList {
+	bindingList := &ResourceBindingList{
+		ResourceBindings: make([]ResourceBinding, 0),
+		ListMeta:         types.ListMeta{TotalItems: len(bindings)},
+	}
+	bindingCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(bindings), dsQuery)
+	bindings = fromCells(bindingCells)
+	bindingList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	bindingList.Errors = nonCriticalErrors
+
+	for i := range bindings {
+		bindingList.ResourceBindings = append(bindingList.ResourceBindings, toResourceBinding(&bindings[i]))
+	}
+	return bindingList
+}
+
+func toResourceBinding(binding *workv1alpha2.ResourceBinding) ResourceBinding {
+	return ResourceBinding{
+		ObjectMeta: types.NewObjectMeta(binding.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindResourceBinding),
+		Resource:   binding.Spec.Resource,
+		Policy:     common.BindingPolicy(binding.Annotations),
+		Replicas:   binding.Spec.Replicas,
+		Clusters:   binding.Spec.Clusters,
+		Conditions: binding.Status.Conditions,
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"sort"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	estimatorclient "github.com/karmada-io/karmada/pkg/estimator/client"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/apienablement"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/clustereviction"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/spreadconstraint"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/tainttoleration"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/informer"
+)
+
+// FilterResult is the outcome of a single scheduler filter plugin for one cluster.
+type FilterResult struct {
+	Plugin  string   `json:"plugin"`
+	Passed  bool     `json:"passed"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// ClusterExplanation explains whether the binding fits a cluster.
+type ClusterExplanation struct {
+	Cluster string `json:"cluster"`
+	Passed  bool   `json:"passed"`
+	// FailedFilter is the first filter plugin that rejected the cluster, in scheduler order.
+	FailedFilter string         `json:"failedFilter,omitempty"`
+	Filters      []FilterResult `json:"filters"`
+	// Score is the sum of the score plugins, only computed for clusters that pass every filter.
+	Score int64 `json:"score"`
+	// AvailableReplicas is estimated from the ResourceSummary of the cluster.
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// Scheduled is true when the binding is currently scheduled to the cluster.
+	Scheduled bool `json:"scheduled"`
+}
+
+// SchedulingExplanation explains the scheduling decision of a ResourceBinding for every cluster.
+type SchedulingExplanation struct {
+	Name      string                       `json:"name"`
+	Namespace string                       `json:"namespace"`
+	Resource  workv1alpha2.ObjectReference `json:"resource"`
+	// ScheduledCondition is the Scheduled condition reported by karmada-scheduler, if any.
+	ScheduledCondition *metav1.Condition    `json:"scheduledCondition,omitempty"`
+	Clusters           []ClusterExplanation `json:"clusters"`
+}
+
+// filterOrder is the order filter results are reported in, plugins not listed here come last.
+var filterOrder = []string{apienablement.Name, tainttoleration.Name, clusteraffinity.Name, spreadconstraint.Name, clustereviction.Name}
+
+// GetSchedulingExplanation evaluates the placement of the ResourceBinding against every registered cluster.
+func GetSchedulingExplanation(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, name string) (*SchedulingExplanation, error) {
+	rb, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SchedulingExplanation{
+		Name:               rb.Name,
+		Namespace:          rb.Namespace,
+		Resource:           rb.Spec.Resource,
+		ScheduledCondition: meta.FindStatusCondition(rb.Status.Conditions, workv1alpha2.Scheduled),
+		Clusters:           ExplainClusters(ctx, &rb.Spec, &rb.Status, clusters.Items),
+	}
+	return result, nil
+}
+
+// ExplainClusters runs the in-tree scheduler filter and score plugins for the binding against each cluster.
+// Unlike the scheduler framework every filter is run, so all the reasons a cluster is rejected are reported.
+func ExplainClusters(ctx context.Context, spec *workv1alpha2.ResourceBindingSpec,
+	status *workv1alpha2.ResourceBindingStatus, clusters []clusterv1alpha1.Cluster) []ClusterExplanation {
+	filters, scorers := inTreePlugins()
+
+	candidates := make([]*clusterv1alpha1.Cluster, 0, len(clusters))
+	for i := range clusters {
+		candidates = append(candidates, &clusters[i])
+	}
+	available := availableReplicas(ctx, spec, candidates)
+	scheduled := sets.New[string]()
+	for _, target := range spec.Clusters {
+		scheduled.Insert(target.Name)
+	}
+
+	explanations := make([]ClusterExplanation, 0, len(candidates))
+	for _, cluster := range candidates {
+		explanation := ClusterExplanation{
+			Cluster:           cluster.Name,
+			Passed:            true,
+			Filters:           make([]FilterResult, 0, len(filters)),
+			AvailableReplicas: available[cluster.Name],
+			Scheduled:         scheduled.Has(cluster.Name),
+		}
+		for _, filter := range filters {
+			filterResult := runFilter(ctx, filter, spec, status, cluster)
+			if !filterResult.Passed && explanation.Passed {
+				explanation.Passed = false
+				explanation.FailedFilter = filter.Name()
+			}
+			explanation.Filters = append(explanation.Filters, filterResult)
+		}
+		if explanation.Passed {
+			for _, scorer := range scorers {
+				score, scoreResult := scorer.Score(ctx, spec, cluster)
+				if scoreResult.IsSuccess() {
+					explanation.Score += score
+				}
+			}
+		}
+		explanations = append(explanations, explanation)
+	}
+	sort.SliceStable(explanations, func(i, j int) bool {
+		if explanations[i].Passed != explanations[j].Passed {
+			return explanations[i].Passed
+		}
+		if explanations[i].Score != explanations[j].Score {
+			return explanations[i].Score > explanations[j].Score
+		}
+		return explanations[i].Cluster < explanations[j].Cluster
+	})
+	return explanations
+}
+
+func inTreePlugins() ([]framework.Plugin, []framework.ScorePlugin) {
+	registry := plugins.NewInTreeRegistry()
+	names := registry.FactoryNames()
+	rank := make(map[string]int, len(filterOrder))
+	for i, name := range filterOrder {
+		rank[name] = i
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		ri, iok := rank[names[i]]
+		rj, jok := rank[names[j]]
+		if iok != jok {
+			return iok
+		}
+		if iok {
+			return ri < rj
+		}
+		return names[i] < names[j]
+	})
+
+	var filters []framework.Plugin
+	var scorers []framework.ScorePlugin
+	for _, name := range names {
+		plugin, err := registry[name]()
+		if err != nil {
+			klog.ErrorS(err, "Failed to initialize scheduler plugin", "plugin", name)
+			continue
+		}
+		switch plugin.(type) {
+		case framework.FilterPluginWithContext, framework.FilterPlugin:
+			filters = append(filters, plugin)
+		}
+		if scorer, ok := plugin.(framework.ScorePlugin); ok {
+			scorers = append(scorers, scorer)
+		}
+	}
+	return filters, scorers
+}
+
+func runFilter(ctx context.Context, plugin framework.Plugin, spec *workv1alpha2.ResourceBindingSpec,
+	status *workv1alpha2.ResourceBindingStatus, cluster *clusterv1alpha1.Cluster) FilterResult {
+	var result *framework.Result
+	switch filter := plugin.(type) {
+	case framework.FilterPluginWithContext:
+		result = filter.FilterWithContext(&framework.FilterContext{
+			Context:                ctx,
+			BindingSpec:            spec,
+			BindingStatus:          status,
+			Cluster:                cluster,
+			ResourceBindingIndexer: informer.ResourceBindingIndexer(),
+		})
+	case framework.FilterPlugin:
+		result = filter.Filter(ctx, spec, status, cluster)
+	}
+	filterResult := FilterResult{Plugin: plugin.Name(), Passed: result.IsSuccess()}
+	if !filterResult.Passed {
+		filterResult.Reasons = result.Reasons()
+	}
+	return filterResult
+}
+
+// availableReplicas estimates how many replicas of the binding every cluster can hold, by ResourceSummary.
+func availableReplicas(ctx context.Context, spec *workv1alpha2.ResourceBindingSpec, clusters []*clusterv1alpha1.Cluster) map[string]int32 {
+	available := make(map[string]int32, len(clusters))
+	targets, err := estimatorclient.NewGeneralEstimator().MaxAvailableReplicas(ctx, estimatorclient.ReplicaEstimationRequest{
+		Clusters:            clusters,
+		ReplicaRequirements: spec.ReplicaRequirements,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to estimate available replicas")
+		return available
+	}
+	for _, target := range targets {
+		available[target.Name] = target.Replicas
+	}
+	return available
+}
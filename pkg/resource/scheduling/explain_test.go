@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCluster(name string, taints ...corev1.Taint) clusterv1alpha1.Cluster {
+	return clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       clusterv1alpha1.ClusterSpec{Taints: taints},
+		Status: clusterv1alpha1.ClusterStatus{
+			APIEnablements: []clusterv1alpha1.APIEnablement{{
+				GroupVersion: "apps/v1",
+				Resources:    []clusterv1alpha1.APIResource{{Name: "deployments", Kind: "Deployment"}},
+			}},
+		},
+	}
+}
+
+func TestExplainClusters(t *testing.T) {
+	spec := &workv1alpha2.ResourceBindingSpec{
+		Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+		Placement: &policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1", "member2"}},
+		},
+		Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 1}},
+	}
+	clusters := []clusterv1alpha1.Cluster{
+		newCluster("member3"),
+		newCluster("member2", corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule}),
+		newCluster("member1"),
+	}
+
+	explanations := ExplainClusters(context.TODO(), spec, &workv1alpha2.ResourceBindingStatus{}, clusters)
+	if len(explanations) != 3 {
+		t.Fatalf("ExplainClusters() returned %d clusters, expected 3", len(explanations))
+	}
+	expected := []struct {
+		cluster      string
+		passed       bool
+		failedFilter string
+	}{
+		{"member1", true, ""},
+		{"member2", false, "TaintToleration"},
+		{"member3", false, "ClusterAffinity"},
+	}
+	for i, e := range expected {
+		got := explanations[i]
+		if got.Cluster != e.cluster || got.Passed != e.passed || got.FailedFilter != e.failedFilter {
+			t.Errorf("ExplainClusters()[%d] = %s passed=%t failedFilter=%q, expected %s passed=%t failedFilter=%q",
+				i, got.Cluster, got.Passed, got.FailedFilter, e.cluster, e.passed, e.failedFilter)
+		}
+	}
+	if !explanations[0].Scheduled {
+		t.Errorf("ExplainClusters() expected member1 to be reported as scheduled")
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
+	schedulercache "github.com/karmada-io/karmada/pkg/scheduler/cache"
+	"github.com/karmada-io/karmada/pkg/scheduler/core"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
+// ClusterOverride changes a cluster in the simulated snapshot only.
+type ClusterOverride struct {
+	Name string `json:"name"`
+	// Labels replace the labels of the cluster when set.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Taints replace the taints of the cluster when set, an empty list removes every taint.
+	Taints *[]corev1.Taint `json:"taints,omitempty"`
+	// ResourceSummary replaces the allocatable, allocated and allocating resources of the cluster when set,
+	// the replicas the cluster can hold are estimated from it.
+	ResourceSummary *clusterv1alpha1.ResourceSummary `json:"resourceSummary,omitempty"`
+	// ResourceModels replace the resource models the allocatableModelings of the resource summary are
+	// graded by when set, an empty list removes every model.
+	ResourceModels *[]clusterv1alpha1.ResourceModel `json:"resourceModels,omitempty"`
+}
+
+// SimulationInput is the draft the scheduler is run against.
+type SimulationInput struct {
+	// Namespace and Binding optionally name an existing ResourceBinding. Its spec is used as the base
+	// of the simulation and its current clusters are diffed against the result.
+	Namespace string
+	Binding   string
+	// Resource is required when no binding is given, API enablement is checked against it.
+	Resource            workv1alpha2.ObjectReference
+	Placement           policyv1alpha1.Placement
+	Replicas            int32
+	ReplicaRequirements *workv1alpha2.ReplicaRequirements
+	ClusterOverrides    []ClusterOverride
+}
+
+// ReplicaChange is the difference of replicas on a single cluster.
+type ReplicaChange struct {
+	Cluster   string `json:"cluster"`
+	Current   int32  `json:"current"`
+	Simulated int32  `json:"simulated"`
+	// Added is true when the cluster is only part of the simulated assignment.
+	Added bool `json:"added"`
+	// Removed is true when the cluster is only part of the current assignment.
+	Removed bool `json:"removed"`
+}
+
+// SimulationResult is the outcome of a scheduling simulation.
+type SimulationResult struct {
+	Resource workv1alpha2.ObjectReference `json:"resource"`
+	Replicas int32                        `json:"replicas"`
+	// Assignments is the replica assignment suggested by the scheduler, empty when scheduling failed.
+	Assignments []workv1alpha2.TargetCluster `json:"assignments"`
+	// CurrentClusters is the spec.clusters of the existing binding, empty when no binding was given.
+	CurrentClusters []workv1alpha2.TargetCluster `json:"currentClusters"`
+	// Changes lists every cluster whose replicas differ between the current and the simulated assignment.
+	Changes []ReplicaChange `json:"changes"`
+	// Error is the scheduling failure, e.g. when no cluster fits the placement.
+	Error string `json:"error,omitempty"`
+	// Clusters explains the filter and score results of every cluster in the simulated snapshot.
+	Clusters []ClusterExplanation `json:"clusters"`
+}
+
+// Simulate runs the karmada scheduler in-process against a snapshot of the clusters with the draft
+// placement applied. Nothing is written back to the control plane.
+func Simulate(ctx context.Context, karmadaClient karmadaclientset.Interface, input *SimulationInput) (*SimulationResult, error) {
+	spec := &workv1alpha2.ResourceBindingSpec{Resource: input.Resource}
+	status := &workv1alpha2.ResourceBindingStatus{}
+	if input.Binding != "" {
+		rb, err := karmadaClient.WorkV1alpha2().ResourceBindings(input.Namespace).Get(ctx, input.Binding, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		spec, status = rb.Spec.DeepCopy(), rb.Status.DeepCopy()
+	} else if input.Resource.Kind == "" || input.Resource.APIVersion == "" {
+		return nil, fmt.Errorf("either a binding or a resource apiVersion and kind is required")
+	}
+	current := spec.Clusters
+	placement := input.Placement.DeepCopy()
+	spec.Placement = placement
+	if input.Replicas > 0 {
+		spec.Replicas = input.Replicas
+	}
+	if input.ReplicaRequirements != nil {
+		spec.ReplicaRequirements = input.ReplicaRequirements
+	}
+
+	clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := applyClusterOverrides(clusterList.Items, input.ClusterOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SimulationResult{
+		Resource:        spec.Resource,
+		Replicas:        spec.Replicas,
+		Assignments:     make([]workv1alpha2.TargetCluster, 0),
+		CurrentClusters: append(make([]workv1alpha2.TargetCluster, 0, len(current)), current...),
+		Clusters:        ExplainClusters(ctx, spec, status, clusters),
+	}
+	assignments, err := scheduleSnapshot(ctx, spec, status, clusters)
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Assignments = assignments
+	}
+	result.Changes = diffAssignments(current, result.Assignments)
+	return result, nil
+}
+
+// scheduleSnapshot runs the generic scheduler with the in-tree plugins over an in-memory cluster lister.
+func scheduleSnapshot(ctx context.Context, spec *workv1alpha2.ResourceBindingSpec,
+	status *workv1alpha2.ResourceBindingStatus, clusters []clusterv1alpha1.Cluster) ([]workv1alpha2.TargetCluster, error) {
+	clusterIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for i := range clusters {
+		if err := clusterIndexer.Add(&clusters[i]); err != nil {
+			return nil, err
+		}
+	}
+	bindingIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	schedCache := schedulercache.NewCache(clusterlister.NewClusterLister(clusterIndexer), bindingIndexer, 0)
+
+	algorithm, err := core.NewGenericScheduler(schedCache, plugins.NewInTreeRegistry())
+	if err != nil {
+		return nil, err
+	}
+	scheduled, err := algorithm.Schedule(ctx, spec, status, &core.ScheduleAlgorithmOption{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(scheduled.SuggestedClusters, func(i, j int) bool {
+		return scheduled.SuggestedClusters[i].Name < scheduled.SuggestedClusters[j].Name
+	})
+	return scheduled.SuggestedClusters, nil
+}
+
+func applyClusterOverrides(clusters []clusterv1alpha1.Cluster, overrides []ClusterOverride) ([]clusterv1alpha1.Cluster, error) {
+	snapshot := make([]clusterv1alpha1.Cluster, len(clusters))
+	index := make(map[string]int, len(clusters))
+	for i := range clusters {
+		snapshot[i] = *clusters[i].DeepCopy()
+		index[clusters[i].Name] = i
+	}
+	for _, override := range overrides {
+		i, ok := index[override.Name]
+		if !ok {
+			return nil, fmt.Errorf("cluster %q in overrides does not exist", override.Name)
+		}
+		if override.Labels != nil {
+			snapshot[i].Labels = override.Labels
+		}
+		if override.Taints != nil {
+			snapshot[i].Spec.Taints = *override.Taints
+		}
+		if override.ResourceSummary != nil {
+			snapshot[i].Status.ResourceSummary = override.ResourceSummary.DeepCopy()
+		}
+		if override.ResourceModels != nil {
+			snapshot[i].Spec.ResourceModels = *override.ResourceModels
+		}
+	}
+	return snapshot, nil
+}
+
+func diffAssignments(current, simulated []workv1alpha2.TargetCluster) []ReplicaChange {
+	replicas := make(map[string]*ReplicaChange)
+	for _, target := range current {
+		replicas[target.Name] = &ReplicaChange{Cluster: target.Name, Current: target.Replicas, Removed: true}
+	}
+	for _, target := range simulated {
+		if change, ok := replicas[target.Name]; ok {
+			change.Simulated = target.Replicas
+			change.Removed = false
+			continue
+		}
+		replicas[target.Name] = &ReplicaChange{Cluster: target.Name, Simulated: target.Replicas, Added: true}
+	}
+
+	changes := make([]ReplicaChange, 0, len(replicas))
+	for _, change := range replicas {
+		if change.Added || change.Removed || change.Current != change.Simulated {
+			changes = append(changes, *change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Cluster < changes[j].Cluster
+	})
+	return changes
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSimulate(t *testing.T) {
+	member1, member2 := newCluster("member1"), newCluster("member2")
+	rb := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+			Replicas: 2,
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}},
+		},
+	}
+	karmadaClient := karmadafake.NewSimpleClientset(&member1, &member2, rb)
+
+	result, err := Simulate(context.TODO(), karmadaClient, &SimulationInput{
+		Namespace: "default",
+		Binding:   "nginx-deployment",
+		Placement: policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+			ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+				ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDuplicated,
+			},
+		},
+		ClusterOverrides: []ClusterOverride{{Name: "member2", Labels: map[string]string{"env": "prod"}}},
+	})
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if result.Error != "" {
+		t.Fatalf("Simulate() scheduling error = %s", result.Error)
+	}
+	if len(result.Assignments) != 1 || result.Assignments[0].Name != "member2" || result.Assignments[0].Replicas != 2 {
+		t.Errorf("Simulate() assignments = %v, expected [member2:2]", result.Assignments)
+	}
+	expected := []ReplicaChange{
+		{Cluster: "member1", Current: 2, Removed: true},
+		{Cluster: "member2", Simulated: 2, Added: true},
+	}
+	if len(result.Changes) != len(expected) {
+		t.Fatalf("Simulate() changes = %v, expected %v", result.Changes, expected)
+	}
+	for i := range expected {
+		if result.Changes[i] != expected[i] {
+			t.Errorf("Simulate() changes[%d] = %+v, expected %+v", i, result.Changes[i], expected[i])
+		}
+	}
+
+	// the override must not leak into the control plane
+	stored, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member2", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(stored.Labels) != 0 {
+		t.Errorf("Simulate() modified cluster member2, labels = %v", stored.Labels)
+	}
+}
+
+func newResourceSummary(cpu string) *clusterv1alpha1.ResourceSummary {
+	return &clusterv1alpha1.ResourceSummary{Allocatable: corev1.ResourceList{
+		corev1.ResourceCPU:  resource.MustParse(cpu),
+		corev1.ResourcePods: resource.MustParse("110"),
+	}}
+}
+
+func TestSimulateCapacityOverride(t *testing.T) {
+	member1, member2 := newCluster("member1"), newCluster("member2")
+	member1.Status.ResourceSummary = newResourceSummary("4")
+	member2.Status.ResourceSummary = newResourceSummary("4")
+	karmadaClient := karmadafake.NewSimpleClientset(&member1, &member2)
+	noModels := []clusterv1alpha1.ResourceModel{}
+
+	tests := []struct {
+		name      string
+		overrides []ClusterOverride
+		expected  []workv1alpha2.TargetCluster
+	}{
+		{
+			name:     "replicas follow the current capacity",
+			expected: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 2}},
+		},
+		{
+			name: "replicas follow the overridden capacity",
+			overrides: []ClusterOverride{
+				{Name: "member1", ResourceSummary: newResourceSummary("0"), ResourceModels: &noModels},
+			},
+			expected: []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 4}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Simulate(context.TODO(), karmadaClient, &SimulationInput{
+				Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+				Placement: policyv1alpha1.Placement{
+					ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1", "member2"}},
+					ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+						ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+						ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceWeighted,
+						WeightPreference:          &policyv1alpha1.ClusterPreferences{DynamicWeight: policyv1alpha1.DynamicWeightByAvailableReplicas},
+					},
+				},
+				Replicas: 4,
+				ReplicaRequirements: &workv1alpha2.ReplicaRequirements{
+					ResourceRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+				ClusterOverrides: tt.overrides,
+			})
+			if err != nil {
+				t.Fatalf("Simulate() error = %v", err)
+			}
+			if result.Error != "" {
+				t.Fatalf("Simulate() scheduling error = %s", result.Error)
+			}
+			if len(result.Assignments) != len(tt.expected) {
+				t.Fatalf("Simulate() assignments = %v, expected %v", result.Assignments, tt.expected)
+			}
+			for i := range tt.expected {
+				if result.Assignments[i] != tt.expected[i] {
+					t.Errorf("Simulate() assignments[%d] = %v, expected %v", i, result.Assignments[i], tt.expected[i])
+				}
+			}
+		})
+	}
+
+	// the override must not leak into the control plane
+	stored, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if cpu := stored.Status.ResourceSummary.Allocatable[corev1.ResourceCPU]; cpu.String() != "4" {
+		t.Errorf("Simulate() modified cluster member1, allocatable cpu = %s", cpu.String())
+	}
+}
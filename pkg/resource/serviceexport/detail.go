@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceexport
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsclientset "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+)
+
+// ServiceClusters are the clusters a Service exported through ServiceExport/ServiceImport is
+// exposed from and consumed in.
+type ServiceClusters struct {
+	// ExposingClusters are the clusters the ServiceExport is propagated to.
+	ExposingClusters []string `json:"exposingClusters"`
+	// ConsumingClusters are the clusters the ServiceImport of the same name is propagated to.
+	ConsumingClusters []string `json:"consumingClusters"`
+}
+
+// ServiceExportDetail is a presentation layer view of a ServiceExport.
+type ServiceExportDetail struct {
+	// Extends list item structure.
+	ServiceExport   `json:",inline"`
+	ServiceClusters `json:",inline"`
+
+	Conditions []mcsv1alpha1.ServiceExportCondition `json:"conditions"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetServiceExportDetail gets ServiceExport details, with the clusters the service is exposed
+// from and consumed in.
+func GetServiceExportDetail(ctx context.Context, client mcsclientset.Interface, karmadaClient karmadaclientset.Interface, namespace, name string) (*ServiceExportDetail, error) {
+	export, err := client.MulticlusterV1alpha1().ServiceExports(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := GetServiceClusters(ctx, karmadaClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceExportDetail{
+		ServiceExport:   toServiceExport(export),
+		ServiceClusters: *clusters,
+		Conditions:      export.Status.Conditions,
+		Errors:          []error{},
+	}, nil
+}
+
+// GetServiceClusters resolves the clusters of an exported Service from the ResourceBindings of
+// its ServiceExport and ServiceImport, a kind that is not propagated yet has no clusters.
+func GetServiceClusters(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, name string) (*ServiceClusters, error) {
+	exposing, err := bindingClusters(ctx, karmadaClient, util.ServiceExportKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	consuming, err := bindingClusters(ctx, karmadaClient, util.ServiceImportKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceClusters{ExposingClusters: exposing, ConsumingClusters: consuming}, nil
+}
+
+func bindingClusters(ctx context.Context, karmadaClient karmadaclientset.Interface, kind, namespace, name string) ([]string, error) {
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, names.GenerateBindingName(kind, name), metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	clusters := make([]string, 0, len(binding.Spec.Clusters))
+	for _, target := range binding.Spec.Clusters {
+		clusters = append(clusters, target.Name)
+	}
+	return clusters, nil
+}
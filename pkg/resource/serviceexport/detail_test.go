@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceexport
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsfake "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned/fake"
+)
+
+func newBinding(name string, clusters ...string) *workv1alpha2.ResourceBinding {
+	binding := &workv1alpha2.ResourceBinding{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: name}}
+	for _, cluster := range clusters {
+		binding.Spec.Clusters = append(binding.Spec.Clusters, workv1alpha2.TargetCluster{Name: cluster})
+	}
+	return binding
+}
+
+func TestGetServiceExportDetail(t *testing.T) {
+	export := &mcsv1alpha1.ServiceExport{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Status: mcsv1alpha1.ServiceExportStatus{Conditions: []mcsv1alpha1.ServiceExportCondition{
+			{Type: mcsv1alpha1.ServiceExportValid, Status: corev1.ConditionTrue},
+			{Type: mcsv1alpha1.ServiceExportConflict, Status: corev1.ConditionFalse},
+		}},
+	}
+	mcsClient := mcsfake.NewSimpleClientset(export)
+	karmadaClient := karmadafake.NewSimpleClientset(
+		newBinding("nginx-serviceexport", "member1", "member2"),
+		newBinding("nginx-serviceimport", "member3"),
+	)
+
+	detail, err := GetServiceExportDetail(context.TODO(), mcsClient, karmadaClient, "default", "nginx")
+	if err != nil {
+		t.Fatalf("GetServiceExportDetail() error = %v", err)
+	}
+	if !detail.Valid || detail.Conflict {
+		t.Errorf("GetServiceExportDetail() valid = %v conflict = %v, want valid without conflict", detail.Valid, detail.Conflict)
+	}
+	if want := []string{"member1", "member2"}; !reflect.DeepEqual(detail.ExposingClusters, want) {
+		t.Errorf("GetServiceExportDetail() exposing clusters = %v, want %v", detail.ExposingClusters, want)
+	}
+	if want := []string{"member3"}; !reflect.DeepEqual(detail.ConsumingClusters, want) {
+		t.Errorf("GetServiceExportDetail() consuming clusters = %v, want %v", detail.ConsumingClusters, want)
+	}
+}
+
+func TestGetServiceClustersNotPropagated(t *testing.T) {
+	clusters, err := GetServiceClusters(context.TODO(), karmadafake.NewSimpleClientset(), "default", "nginx")
+	if err != nil {
+		t.Fatalf("GetServiceClusters() error = %v", err)
+	}
+	if len(clusters.ExposingClusters) != 0 || len(clusters.ConsumingClusters) != 0 {
+		t.Errorf("GetServiceClusters() = %+v, want no clusters without bindings", clusters)
+	}
+}
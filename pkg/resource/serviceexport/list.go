@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceexport
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsclientset "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// ServiceExportList contains a list of ServiceExports in the karmada control-plane.
+type ServiceExportList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ServiceExports.
+	ServiceExports []ServiceExport `json:"serviceExports"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ServiceExport contains information about a single ServiceExport.
+type ServiceExport struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Valid and Conflict reflect the conditions of the same type, they are false until reported.
+	Valid    bool `json:"valid"`
+	Conflict bool `json:"conflict"`
+}
+
+// GetServiceExportList returns a list of all ServiceExports in the Karmada control-plane.
+func GetServiceExportList(client mcsclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ServiceExportList, error) {
+	exportList, err := client.MulticlusterV1alpha1().ServiceExports(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toServiceExportList(exportList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toServiceExportList(exports []mcsv1alpha1.ServiceExport, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ServiceExportList {
+	result := &ServiceExportList{
+		ServiceExports: make([]ServiceExport, 0),
+		ListMeta:       types.ListMeta{TotalItems: len(exports)},
+	}
+	exportCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(exports), dsQuery)
+	exports = fromCells(exportCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	result.Errors = nonCriticalErrors
+
+	for i := range exports {
+		result.ServiceExports = append(result.ServiceExports, toServiceExport(&exports[i]))
+	}
+	return result
+}
+
+func toServiceExport(export *mcsv1alpha1.ServiceExport) ServiceExport {
+	return ServiceExport{
+		ObjectMeta: types.NewObjectMeta(export.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindServiceExport),
+		Valid:      hasCondition(export, mcsv1alpha1.ServiceExportValid),
+		Conflict:   hasCondition(export, mcsv1alpha1.ServiceExportConflict),
+	}
+}
+
+func hasCondition(export *mcsv1alpha1.ServiceExport, conditionType mcsv1alpha1.ServiceExportConditionType) bool {
+	for _, condition := range export.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
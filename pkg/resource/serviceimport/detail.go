@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceimport
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsclientset "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/resource/serviceexport"
+)
+
+// ServiceImportDetail is a presentation layer view of a ServiceImport.
+type ServiceImportDetail struct {
+	// Extends list item structure.
+	ServiceImport                 `json:",inline"`
+	serviceexport.ServiceClusters `json:",inline"`
+
+	SessionAffinity corev1.ServiceAffinity `json:"sessionAffinity"`
+	// Clusters are the clusters reported in the status of the ServiceImport.
+	Clusters []mcsv1alpha1.ClusterStatus `json:"clusters"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetServiceImportDetail gets ServiceImport details, with the clusters the service is exposed
+// from and consumed in.
+func GetServiceImportDetail(ctx context.Context, client mcsclientset.Interface, karmadaClient karmadaclientset.Interface, namespace, name string) (*ServiceImportDetail, error) {
+	serviceImport, err := client.MulticlusterV1alpha1().ServiceImports(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := serviceexport.GetServiceClusters(ctx, karmadaClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceImportDetail{
+		ServiceImport:   toServiceImport(serviceImport),
+		ServiceClusters: *clusters,
+		SessionAffinity: serviceImport.Spec.SessionAffinity,
+		Clusters:        serviceImport.Status.Clusters,
+		Errors:          []error{},
+	}, nil
+}
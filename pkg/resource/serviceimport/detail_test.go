@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceimport
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsfake "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned/fake"
+)
+
+func TestGetServiceImportDetail(t *testing.T) {
+	serviceImport := &mcsv1alpha1.ServiceImport{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Spec: mcsv1alpha1.ServiceImportSpec{
+			Type:  mcsv1alpha1.ClusterSetIP,
+			Ports: []mcsv1alpha1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+	mcsClient := mcsfake.NewSimpleClientset(serviceImport)
+	karmadaClient := karmadafake.NewSimpleClientset(&workv1alpha2.ResourceBinding{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx-serviceimport"},
+		Spec:       workv1alpha2.ResourceBindingSpec{Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}}},
+	})
+
+	detail, err := GetServiceImportDetail(context.TODO(), mcsClient, karmadaClient, "default", "nginx")
+	if err != nil {
+		t.Fatalf("GetServiceImportDetail() error = %v", err)
+	}
+	if detail.Type != mcsv1alpha1.ClusterSetIP || len(detail.Ports) != 1 {
+		t.Errorf("GetServiceImportDetail() type = %s ports = %v, want ClusterSetIP with one port", detail.Type, detail.Ports)
+	}
+	if want := []string{"member2"}; !reflect.DeepEqual(detail.ConsumingClusters, want) {
+		t.Errorf("GetServiceImportDetail() consuming clusters = %v, want %v", detail.ConsumingClusters, want)
+	}
+	if len(detail.ExposingClusters) != 0 {
+		t.Errorf("GetServiceImportDetail() exposing clusters = %v, want none without a ServiceExport binding", detail.ExposingClusters)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceimport
+
+import (
+	"context"
+
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsclientset "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// ServiceImportList contains a list of ServiceImports in the karmada control-plane.
+type ServiceImportList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ServiceImports.
+	ServiceImports []ServiceImport `json:"serviceImports"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ServiceImport contains information about a single ServiceImport.
+type ServiceImport struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	Type  mcsv1alpha1.ServiceImportType `json:"type"`
+	IPs   []string                      `json:"ips"`
+	Ports []mcsv1alpha1.ServicePort     `json:"ports"`
+}
+
+// GetServiceImportList returns a list of all ServiceImports in the Karmada control-plane.
+func GetServiceImportList(client mcsclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ServiceImportList, error) {
+	importList, err := client.MulticlusterV1alpha1().ServiceImports(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toServiceImportList(importList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toServiceImportList(imports []mcsv1alpha1.ServiceImport, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ServiceImportList {
+	result := &ServiceImportList{
+		ServiceImports: make([]ServiceImport, 0),
+		ListMeta:       types.ListMeta{TotalItems: len(imports)},
+	}
+	importCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(imports), dsQuery)
+	imports = fromCells(importCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	result.Errors = nonCriticalErrors
+
+	for i := range imports {
+		result.ServiceImports = append(result.ServiceImports, toServiceImport(&imports[i]))
+	}
+	return result
+}
+
+func toServiceImport(serviceImport *mcsv1alpha1.ServiceImport) ServiceImport {
+	return ServiceImport{
+		ObjectMeta: types.NewObjectMeta(serviceImport.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindServiceImport),
+		Type:       serviceImport.Spec.Type,
+		IPs:        serviceImport.Spec.IPs,
+		Ports:      serviceImport.Spec.Ports,
+	}
+}
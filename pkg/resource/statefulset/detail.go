@@ -33,6 +33,9 @@ type StatefulSetDetail struct {
 	// Extends list item structure.
 	StatefulSet `json:",inline"`
 
+	// Status of the StatefulSet in every member cluster it is scheduled to.
+	MemberStatus []common.MemberStatus `json:"memberStatus"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -59,7 +62,8 @@ func GetStatefulSetDetail(client kubernetes.Interface, namespace,
 
 func getStatefulSetDetail(statefulSet *apps.StatefulSet, podInfo *common.PodInfo, nonCriticalErrors []error) StatefulSetDetail {
 	return StatefulSetDetail{
-		StatefulSet: toStatefulSet(statefulSet, podInfo),
-		Errors:      nonCriticalErrors,
+		StatefulSet:  toStatefulSet(statefulSet, podInfo),
+		MemberStatus: common.GetMemberStatuses(statefulSet.UID),
+		Errors:       nonCriticalErrors,
 	}
 }
@@ -48,6 +48,10 @@ type StatefulSet struct {
 	Pods                common.PodInfo   `json:"podInfo"`
 	ContainerImages     []string         `json:"containerImages"`
 	InitContainerImages []string         `json:"initContainerImages"`
+
+	// Member clusters the StatefulSet is scheduled to and healthy in, only set for resource templates
+	// listed from the Karmada control plane.
+	Clusters *common.ClusterSummary `json:"clusters,omitempty"`
 }
 
 // GetStatefulSetList returns a list of all Stateful Sets in the cluster.
@@ -124,3 +128,11 @@ func toStatefulSet(statefulSet *apps.StatefulSet, podInfo *common.PodInfo) State
 		Pods:                *podInfo,
 	}
 }
+
+// AddClusterSummaries sets the member clusters of every StatefulSet of the list, which must have been
+// listed from the Karmada control plane.
+func (list *StatefulSetList) AddClusterSummaries() {
+	for i := range list.StatefulSets {
+		list.StatefulSets[i].Clusters = common.GetClusterSummary(list.StatefulSets[i].ObjectMeta.UID)
+	}
+}
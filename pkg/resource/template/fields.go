@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Port is a container port of a workload, or a port of a Service.
+type Port struct {
+	Name string `json:"name"`
+	Port int32  `json:"port"`
+	// TargetPort is the container port a Service port forwards to, Port by default.
+	TargetPort int32           `json:"targetPort"`
+	Protocol   corev1.Protocol `json:"protocol"`
+}
+
+// IngressRule routes a path of a host to a Service port.
+type IngressRule struct {
+	Host string `json:"host"`
+	// Path is matched as a prefix, "/" by default.
+	Path        string `json:"path"`
+	ServiceName string `json:"serviceName"`
+	ServicePort int32  `json:"servicePort"`
+}
+
+// Fields are the structured fields of a resource template, an alternative to its YAML content.
+// Every kind only uses the fields that apply to it. On update a field left empty keeps its
+// current value, the others replace it, except Labels, which are merged.
+type Fields struct {
+	// Labels of the resource. The pods of a workload get them as well and are selected by them,
+	// app=<name> is used when a workload is created without labels.
+	Labels map[string]string `json:"labels"`
+
+	// Image, Command, Env and Ports describe the container of a StatefulSet, DaemonSet, Job or
+	// CronJob. Ports are the ports of a Service as well.
+	Image   string            `json:"image"`
+	Command []string          `json:"command"`
+	Env     map[string]string `json:"env"`
+	Ports   []Port            `json:"ports"`
+	// Replicas and ServiceName of a StatefulSet.
+	Replicas    *int32 `json:"replicas"`
+	ServiceName string `json:"serviceName"`
+	// Schedule of a CronJob, in cron format.
+	Schedule string `json:"schedule"`
+
+	// ServiceType and Selector of a Service.
+	ServiceType corev1.ServiceType `json:"serviceType"`
+	Selector    map[string]string  `json:"selector"`
+
+	// IngressClassName and Rules of an Ingress.
+	IngressClassName string        `json:"ingressClassName"`
+	Rules            []IngressRule `json:"rules"`
+
+	// Data of a ConfigMap or Secret, and the type of a Secret.
+	Data       map[string]string `json:"data"`
+	SecretType corev1.SecretType `json:"secretType"`
+}
+
+func mergeLabels(labels, fields map[string]string) map[string]string {
+	if len(fields) == 0 {
+		return labels
+	}
+	if labels == nil {
+		labels = make(map[string]string, len(fields))
+	}
+	for k, v := range fields {
+		labels[k] = v
+	}
+	return labels
+}
+
+// applyPodTemplate sets the fields on the pod template and its first container, and defaults
+// the selector, if any, to the pod labels.
+func applyPodTemplate(obj metav1.Object, template *corev1.PodTemplateSpec, selector **metav1.LabelSelector, f *Fields) error {
+	obj.SetLabels(mergeLabels(obj.GetLabels(), f.Labels))
+	template.Labels = mergeLabels(template.Labels, f.Labels)
+	if len(template.Labels) == 0 {
+		template.Labels = map[string]string{"app": obj.GetName()}
+	}
+	if selector != nil && *selector == nil {
+		*selector = &metav1.LabelSelector{MatchLabels: template.Labels}
+	}
+
+	if len(template.Spec.Containers) == 0 {
+		template.Spec.Containers = []corev1.Container{{Name: obj.GetName()}}
+	}
+	container := &template.Spec.Containers[0]
+	if f.Image != "" {
+		container.Image = f.Image
+	}
+	if f.Command != nil {
+		container.Command = f.Command
+	}
+	if f.Env != nil {
+		keys := make([]string, 0, len(f.Env))
+		for key := range f.Env {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		container.Env = make([]corev1.EnvVar, 0, len(keys))
+		for _, key := range keys {
+			container.Env = append(container.Env, corev1.EnvVar{Name: key, Value: f.Env[key]})
+		}
+	}
+	if f.Ports != nil {
+		container.Ports = make([]corev1.ContainerPort, 0, len(f.Ports))
+		for _, port := range f.Ports {
+			container.Ports = append(container.Ports, corev1.ContainerPort{Name: port.Name, ContainerPort: port.Port, Protocol: port.Protocol})
+		}
+	}
+	if container.Image == "" {
+		return apierrors.NewBadRequest("image is required")
+	}
+	return nil
+}
+
+// applyJobTemplate is applyPodTemplate for the pods of a Job, which must not restart always. The
+// Job controller generates the selector of a Job itself.
+func applyJobTemplate(obj metav1.Object, spec *batchv1.JobSpec, f *Fields) error {
+	if err := applyPodTemplate(obj, &spec.Template, nil, f); err != nil {
+		return err
+	}
+	if spec.Template.Spec.RestartPolicy == "" {
+		spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+	return nil
+}
+
+func applyStatefulSetFields(sts *appsv1.StatefulSet, f *Fields) error {
+	if f.Replicas != nil {
+		sts.Spec.Replicas = f.Replicas
+	}
+	if f.ServiceName != "" {
+		sts.Spec.ServiceName = f.ServiceName
+	}
+	return applyPodTemplate(sts, &sts.Spec.Template, &sts.Spec.Selector, f)
+}
+
+func applyDaemonSetFields(ds *appsv1.DaemonSet, f *Fields) error {
+	return applyPodTemplate(ds, &ds.Spec.Template, &ds.Spec.Selector, f)
+}
+
+func applyJobFields(job *batchv1.Job, f *Fields) error {
+	return applyJobTemplate(job, &job.Spec, f)
+}
+
+func applyCronJobFields(cronJob *batchv1.CronJob, f *Fields) error {
+	if f.Schedule != "" {
+		cronJob.Spec.Schedule = f.Schedule
+	}
+	if cronJob.Spec.Schedule == "" {
+		return apierrors.NewBadRequest("schedule is required")
+	}
+	return applyJobTemplate(cronJob, &cronJob.Spec.JobTemplate.Spec, f)
+}
+
+func applyServiceFields(service *corev1.Service, f *Fields) error {
+	service.Labels = mergeLabels(service.Labels, f.Labels)
+	if f.ServiceType != "" {
+		service.Spec.Type = f.ServiceType
+	}
+	if f.Selector != nil {
+		service.Spec.Selector = f.Selector
+	}
+	if f.Ports != nil {
+		service.Spec.Ports = make([]corev1.ServicePort, 0, len(f.Ports))
+		for _, port := range f.Ports {
+			targetPort := port.TargetPort
+			if targetPort == 0 {
+				targetPort = port.Port
+			}
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name:       port.Name,
+				Port:       port.Port,
+				TargetPort: intstr.FromInt32(targetPort),
+				Protocol:   port.Protocol,
+			})
+		}
+	}
+	if len(service.Spec.Ports) == 0 && service.Spec.Type != corev1.ServiceTypeExternalName {
+		return apierrors.NewBadRequest("ports are required")
+	}
+	return nil
+}
+
+func applyIngressFields(ingress *networkingv1.Ingress, f *Fields) error {
+	ingress.Labels = mergeLabels(ingress.Labels, f.Labels)
+	if f.IngressClassName != "" {
+		ingress.Spec.IngressClassName = &f.IngressClassName
+	}
+	if f.Rules != nil {
+		ingress.Spec.Rules = make([]networkingv1.IngressRule, 0, len(f.Rules))
+		byHost := make(map[string]int)
+		for _, rule := range f.Rules {
+			if rule.ServiceName == "" || rule.ServicePort == 0 {
+				return apierrors.NewBadRequest(fmt.Sprintf("the rule of host %q needs a service name and port", rule.Host))
+			}
+			i, ok := byHost[rule.Host]
+			if !ok {
+				i = len(ingress.Spec.Rules)
+				byHost[rule.Host] = i
+				ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{
+					Host:             rule.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{}},
+				})
+			}
+			path := rule.Path
+			if path == "" {
+				path = "/"
+			}
+			pathType := networkingv1.PathTypePrefix
+			http := ingress.Spec.Rules[i].HTTP
+			http.Paths = append(http.Paths, networkingv1.HTTPIngressPath{
+				Path:     path,
+				PathType: &pathType,
+				Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+					Name: rule.ServiceName,
+					Port: networkingv1.ServiceBackendPort{Number: rule.ServicePort},
+				}},
+			})
+		}
+	}
+	if len(ingress.Spec.Rules) == 0 && ingress.Spec.DefaultBackend == nil {
+		return apierrors.NewBadRequest("rules are required")
+	}
+	return nil
+}
+
+func applyConfigMapFields(configMap *corev1.ConfigMap, f *Fields) error {
+	configMap.Labels = mergeLabels(configMap.Labels, f.Labels)
+	if f.Data != nil {
+		configMap.Data = f.Data
+	}
+	return nil
+}
+
+func applySecretFields(secret *corev1.Secret, f *Fields) error {
+	secret.Labels = mergeLabels(secret.Labels, f.Labels)
+	if f.SecretType != "" {
+		secret.Type = f.SecretType
+	}
+	if f.Data != nil {
+		secret.Data = make(map[string][]byte, len(f.Data))
+		for k, v := range f.Data {
+			secret.Data[k] = []byte(v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package template creates, updates and deletes typed resource templates in the Karmada control
+// plane, optionally together with the PropagationPolicy that places them.
+package template
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// Kind is the kind of a resource template.
+type Kind string
+
+// Kind constants define the kinds of resource templates that can be managed.
+const (
+	StatefulSetKind Kind = "StatefulSet"
+	DaemonSetKind   Kind = "DaemonSet"
+	JobKind         Kind = "Job"
+	CronJobKind     Kind = "CronJob"
+	ServiceKind     Kind = "Service"
+	IngressKind     Kind = "Ingress"
+	ConfigMapKind   Kind = "ConfigMap"
+	SecretKind      Kind = "Secret"
+)
+
+// PolicyOptions describes the PropagationPolicy created together with a resource template. Either
+// Content holds a complete policy, or the policy is generated from Name and ClusterNames.
+type PolicyOptions struct {
+	// Name of the generated policy, "<name>-<kind>" by default.
+	Name string
+	// ClusterNames are the clusters the generated policy propagates the resource to.
+	ClusterNames []string
+	// Content is a PropagationPolicy in YAML. The resource is selected when it has no resource selectors.
+	Content string
+}
+
+// Result is a resource template and the PropagationPolicy written along with it.
+type Result struct {
+	Object            runtime.Object                    `json:"object"`
+	PropagationPolicy *policyv1alpha1.PropagationPolicy `json:"propagationPolicy,omitempty"`
+}
+
+type object interface {
+	metav1.Object
+	runtime.Object
+}
+
+// typedClient is the subset of a typed client-go resource interface used here.
+type typedClient[T object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Create(ctx context.Context, obj T, opts metav1.CreateOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+// kindHandler hides the type of the resource behind object.
+type kindHandler interface {
+	groupVersionKind() schema.GroupVersionKind
+	decode(content string) (object, error)
+	newEmpty() object
+	applyFields(obj object, f *Fields) error
+	get(ctx context.Context, k8sClient kubernetes.Interface, namespace, name string) (object, error)
+	create(ctx context.Context, k8sClient kubernetes.Interface, obj object) (object, error)
+	update(ctx context.Context, k8sClient kubernetes.Interface, obj object) (object, error)
+	delete(ctx context.Context, k8sClient kubernetes.Interface, namespace, name string) error
+}
+
+type typedHandler[T object] struct {
+	gvk       schema.GroupVersionKind
+	newObject func() T
+	client    func(k8sClient kubernetes.Interface, namespace string) typedClient[T]
+	apply     func(obj T, f *Fields) error
+}
+
+func (h typedHandler[T]) groupVersionKind() schema.GroupVersionKind {
+	return h.gvk
+}
+
+// decode parses the content strictly, so that misspelled fields are reported instead of dropped.
+func (h typedHandler[T]) decode(content string) (object, error) {
+	obj := h.newObject()
+	if err := yaml.UnmarshalStrict([]byte(content), obj); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid %s: %v", h.gvk.Kind, err))
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if (gvk.Kind != "" && gvk.Kind != h.gvk.Kind) || (gvk.Version != "" && gvk.GroupVersion() != h.gvk.GroupVersion()) {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected %s %s, got %s %s",
+			h.gvk.GroupVersion(), h.gvk.Kind, gvk.GroupVersion(), gvk.Kind))
+	}
+	return obj, nil
+}
+
+func (h typedHandler[T]) newEmpty() object {
+	return h.newObject()
+}
+
+func (h typedHandler[T]) applyFields(obj object, f *Fields) error {
+	return h.apply(obj.(T), f)
+}
+
+func (h typedHandler[T]) get(ctx context.Context, k8sClient kubernetes.Interface, namespace, name string) (object, error) {
+	return h.client(k8sClient, namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (h typedHandler[T]) create(ctx context.Context, k8sClient kubernetes.Interface, obj object) (object, error) {
+	return h.client(k8sClient, obj.GetNamespace()).Create(ctx, obj.(T), metav1.CreateOptions{})
+}
+
+func (h typedHandler[T]) update(ctx context.Context, k8sClient kubernetes.Interface, obj object) (object, error) {
+	return h.client(k8sClient, obj.GetNamespace()).Update(ctx, obj.(T), metav1.UpdateOptions{})
+}
+
+func (h typedHandler[T]) delete(ctx context.Context, k8sClient kubernetes.Interface, namespace, name string) error {
+	return h.client(k8sClient, namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+var handlers = map[Kind]kindHandler{
+	StatefulSetKind: typedHandler[*appsv1.StatefulSet]{
+		gvk:       appsv1.SchemeGroupVersion.WithKind(string(StatefulSetKind)),
+		newObject: func() *appsv1.StatefulSet { return &appsv1.StatefulSet{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*appsv1.StatefulSet] {
+			return k8sClient.AppsV1().StatefulSets(namespace)
+		},
+		apply: applyStatefulSetFields,
+	},
+	DaemonSetKind: typedHandler[*appsv1.DaemonSet]{
+		gvk:       appsv1.SchemeGroupVersion.WithKind(string(DaemonSetKind)),
+		newObject: func() *appsv1.DaemonSet { return &appsv1.DaemonSet{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*appsv1.DaemonSet] {
+			return k8sClient.AppsV1().DaemonSets(namespace)
+		},
+		apply: applyDaemonSetFields,
+	},
+	JobKind: typedHandler[*batchv1.Job]{
+		gvk:       batchv1.SchemeGroupVersion.WithKind(string(JobKind)),
+		newObject: func() *batchv1.Job { return &batchv1.Job{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*batchv1.Job] {
+			return k8sClient.BatchV1().Jobs(namespace)
+		},
+		apply: applyJobFields,
+	},
+	CronJobKind: typedHandler[*batchv1.CronJob]{
+		gvk:       batchv1.SchemeGroupVersion.WithKind(string(CronJobKind)),
+		newObject: func() *batchv1.CronJob { return &batchv1.CronJob{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*batchv1.CronJob] {
+			return k8sClient.BatchV1().CronJobs(namespace)
+		},
+		apply: applyCronJobFields,
+	},
+	ServiceKind: typedHandler[*corev1.Service]{
+		gvk:       corev1.SchemeGroupVersion.WithKind(string(ServiceKind)),
+		newObject: func() *corev1.Service { return &corev1.Service{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*corev1.Service] {
+			return k8sClient.CoreV1().Services(namespace)
+		},
+		apply: applyServiceFields,
+	},
+	IngressKind: typedHandler[*networkingv1.Ingress]{
+		gvk:       networkingv1.SchemeGroupVersion.WithKind(string(IngressKind)),
+		newObject: func() *networkingv1.Ingress { return &networkingv1.Ingress{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*networkingv1.Ingress] {
+			return k8sClient.NetworkingV1().Ingresses(namespace)
+		},
+		apply: applyIngressFields,
+	},
+	ConfigMapKind: typedHandler[*corev1.ConfigMap]{
+		gvk:       corev1.SchemeGroupVersion.WithKind(string(ConfigMapKind)),
+		newObject: func() *corev1.ConfigMap { return &corev1.ConfigMap{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*corev1.ConfigMap] {
+			return k8sClient.CoreV1().ConfigMaps(namespace)
+		},
+		apply: applyConfigMapFields,
+	},
+	SecretKind: typedHandler[*corev1.Secret]{
+		gvk:       corev1.SchemeGroupVersion.WithKind(string(SecretKind)),
+		newObject: func() *corev1.Secret { return &corev1.Secret{} },
+		client: func(k8sClient kubernetes.Interface, namespace string) typedClient[*corev1.Secret] {
+			return k8sClient.CoreV1().Secrets(namespace)
+		},
+		apply: applySecretFields,
+	},
+}
+
+func handlerFor(kind Kind) (kindHandler, error) {
+	h, ok := handlers[kind]
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("unsupported resource template kind %s", kind))
+	}
+	return h, nil
+}
+
+// buildObject returns the resource described by either the content or the fields, and reconciles
+// its namespace and name with the ones of the request. The fields are applied to a copy of current,
+// or to an empty resource when current is nil.
+func buildObject(h kindHandler, content string, fields *Fields, current object, namespace, name string) (object, error) {
+	var obj object
+	switch {
+	case content != "" && fields != nil:
+		return nil, apierrors.NewBadRequest("either content or fields can be set, not both")
+	case content != "":
+		var err error
+		if obj, err = h.decode(content); err != nil {
+			return nil, err
+		}
+	case fields != nil:
+		if current != nil {
+			obj = current.DeepCopyObject().(object)
+		} else {
+			obj = h.newEmpty()
+		}
+	default:
+		return nil, apierrors.NewBadRequest("either content or fields is required")
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(namespace)
+	} else if namespace != "" && obj.GetNamespace() != namespace {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("namespace %s of the content does not match namespace %s", obj.GetNamespace(), namespace))
+	}
+	if obj.GetName() == "" {
+		obj.SetName(name)
+	} else if name != "" && obj.GetName() != name {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("name %s of the content does not match name %s", obj.GetName(), name))
+	}
+	if obj.GetName() == "" {
+		return nil, apierrors.NewBadRequest("name is required")
+	}
+	if fields != nil {
+		if err := h.applyFields(obj, fields); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+// buildPolicy returns the PropagationPolicy described by the options for the resource.
+func buildPolicy(gvk schema.GroupVersionKind, obj object, opts *PolicyOptions) (*policyv1alpha1.PropagationPolicy, error) {
+	selector := policyv1alpha1.ResourceSelector{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+	}
+	policy := &policyv1alpha1.PropagationPolicy{}
+	if opts.Content != "" {
+		if err := yaml.UnmarshalStrict([]byte(opts.Content), policy); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid PropagationPolicy: %v", err))
+		}
+		if policy.Namespace != "" && policy.Namespace != obj.GetNamespace() {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("PropagationPolicy namespace %s does not match namespace %s", policy.Namespace, obj.GetNamespace()))
+		}
+		if len(policy.Spec.ResourceSelectors) == 0 {
+			policy.Spec.ResourceSelectors = []policyv1alpha1.ResourceSelector{selector}
+		}
+	} else {
+		if len(opts.ClusterNames) == 0 {
+			return nil, apierrors.NewBadRequest("the PropagationPolicy needs either content or cluster names")
+		}
+		policy.Spec = policyv1alpha1.PropagationSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{selector},
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: opts.ClusterNames},
+			},
+		}
+	}
+	policy.Namespace = obj.GetNamespace()
+	if opts.Name != "" {
+		policy.Name = opts.Name
+	}
+	if policy.Name == "" {
+		policy.Name = fmt.Sprintf("%s-%s", obj.GetName(), strings.ToLower(gvk.Kind))
+	}
+	return policy, nil
+}
+
+// applyPolicy creates the policy, or updates it when it already exists and update is true.
+func applyPolicy(ctx context.Context, karmadaClient karmadaclientset.Interface,
+	policy *policyv1alpha1.PropagationPolicy, update bool, dryRun bool) (*policyv1alpha1.PropagationPolicy, error) {
+	policies := karmadaClient.PolicyV1alpha1().PropagationPolicies(policy.Namespace)
+	var dryRunOption []string
+	if dryRun {
+		dryRunOption = []string{metav1.DryRunAll}
+	}
+	if update {
+		current, err := policies.Get(ctx, policy.Name, metav1.GetOptions{})
+		if err == nil {
+			current.Spec = policy.Spec
+			return policies.Update(ctx, current, metav1.UpdateOptions{DryRun: dryRunOption})
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return policies.Create(ctx, policy, metav1.CreateOptions{DryRun: dryRunOption})
+}
+
+// Create creates a resource template from its YAML content or its fields. When policy is set the
+// PropagationPolicy is created as well; the resource is deleted again when that fails.
+func Create(ctx context.Context, k8sClient kubernetes.Interface, karmadaClient karmadaclientset.Interface,
+	kind Kind, namespace, name, content string, fields *Fields, policyOpts *PolicyOptions) (*Result, error) {
+	h, err := handlerFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := buildObject(h, content, fields, nil, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	var policy *policyv1alpha1.PropagationPolicy
+	if policyOpts != nil {
+		if policy, err = buildPolicy(h.groupVersionKind(), obj, policyOpts); err != nil {
+			return nil, err
+		}
+		// validate the policy before anything is written
+		if _, err = applyPolicy(ctx, karmadaClient, policy, false, true); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := h.create(ctx, k8sClient, obj)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Object: created}
+	if policy == nil {
+		return result, nil
+	}
+	if result.PropagationPolicy, err = applyPolicy(ctx, karmadaClient, policy, false, false); err != nil {
+		if rollbackErr := h.delete(ctx, k8sClient, created.GetNamespace(), created.GetName()); rollbackErr != nil {
+			klog.ErrorS(rollbackErr, "Failed to roll back resource template", "kind", kind,
+				"namespace", created.GetNamespace(), "name", created.GetName())
+			return nil, fmt.Errorf("failed to create PropagationPolicy: %w, and failed to delete %s %s/%s again: %v",
+				err, kind, created.GetNamespace(), created.GetName(), rollbackErr)
+		}
+		return nil, fmt.Errorf("failed to create PropagationPolicy, %s %s/%s was deleted again: %w",
+			kind, created.GetNamespace(), created.GetName(), err)
+	}
+	return result, nil
+}
+
+// Update replaces a resource template with its YAML content, or changes the given fields of it.
+// When policy is set the PropagationPolicy is created or updated as well; the resource is restored
+// when that fails.
+func Update(ctx context.Context, k8sClient kubernetes.Interface, karmadaClient karmadaclientset.Interface,
+	kind Kind, namespace, name, content string, fields *Fields, policyOpts *PolicyOptions) (*Result, error) {
+	h, err := handlerFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := h.get(ctx, k8sClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := buildObject(h, content, fields, previous, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if obj.GetResourceVersion() == "" {
+		obj.SetResourceVersion(previous.GetResourceVersion())
+	}
+	var policy *policyv1alpha1.PropagationPolicy
+	if policyOpts != nil {
+		if policy, err = buildPolicy(h.groupVersionKind(), obj, policyOpts); err != nil {
+			return nil, err
+		}
+		if _, err = applyPolicy(ctx, karmadaClient, policy, true, true); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := h.update(ctx, k8sClient, obj)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Object: updated}
+	if policy == nil {
+		return result, nil
+	}
+	if result.PropagationPolicy, err = applyPolicy(ctx, karmadaClient, policy, true, false); err != nil {
+		previous.SetResourceVersion(updated.GetResourceVersion())
+		if _, rollbackErr := h.update(ctx, k8sClient, previous); rollbackErr != nil {
+			klog.ErrorS(rollbackErr, "Failed to roll back resource template", "kind", kind, "namespace", namespace, "name", name)
+			return nil, fmt.Errorf("failed to apply PropagationPolicy: %w, and failed to restore %s %s/%s: %v",
+				err, kind, namespace, name, rollbackErr)
+		}
+		return nil, fmt.Errorf("failed to apply PropagationPolicy, %s %s/%s was restored: %w", kind, namespace, name, err)
+	}
+	return result, nil
+}
+
+// Delete deletes a resource template.
+func Delete(ctx context.Context, k8sClient kubernetes.Interface, kind Kind, namespace, name string) error {
+	h, err := handlerFor(kind)
+	if err != nil {
+		return err
+	}
+	return h.delete(ctx, k8sClient, namespace, name)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const configMapContent = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  key: value
+`
+
+// newKarmadaClient returns a fake karmada client that honors dry-run creates of policies and
+// fails the real ones when failPolicies is set.
+func newKarmadaClient(failPolicies bool) *karmadafake.Clientset {
+	karmadaClient := karmadafake.NewSimpleClientset()
+	karmadaClient.PrependReactor("create", "propagationpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create := action.(k8stesting.CreateActionImpl)
+		if len(create.CreateOptions.DryRun) > 0 {
+			return true, create.GetObject(), nil
+		}
+		if failPolicies {
+			return true, nil, fmt.Errorf("admission denied")
+		}
+		return false, nil, nil
+	})
+	return karmadaClient
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := fake.NewSimpleClientset()
+	karmadaClient := newKarmadaClient(false)
+
+	result, err := Create(ctx, k8sClient, karmadaClient, ConfigMapKind, "default", "", configMapContent, nil,
+		&PolicyOptions{ClusterNames: []string{"member1"}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	policy := result.PropagationPolicy
+	if policy == nil || policy.Name != "settings-configmap" || policy.Namespace != "default" {
+		t.Fatalf("unexpected policy %+v", policy)
+	}
+	if selector := policy.Spec.ResourceSelectors[0]; selector.Kind != "ConfigMap" || selector.APIVersion != "v1" || selector.Name != "settings" {
+		t.Errorf("unexpected resource selector %+v", selector)
+	}
+	if _, err = k8sClient.CoreV1().ConfigMaps("default").Get(ctx, "settings", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected the ConfigMap to be created, got %v", err)
+	}
+}
+
+func TestCreateRollsBackWhenPolicyFails(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := fake.NewSimpleClientset()
+
+	_, err := Create(ctx, k8sClient, newKarmadaClient(true), ConfigMapKind, "default", "", configMapContent, nil,
+		&PolicyOptions{ClusterNames: []string{"member1"}})
+	if err == nil {
+		t.Fatalf("expected Create() to fail")
+	}
+	if _, err = k8sClient.CoreV1().ConfigMaps("default").Get(ctx, "settings", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the ConfigMap to be deleted again, got %v", err)
+	}
+}
+
+func TestCreateValidatesContent(t *testing.T) {
+	tests := map[string]struct {
+		kind    Kind
+		content string
+	}{
+		"unknown field": {kind: ConfigMapKind, content: "metadata:\n  name: settings\ndatta:\n  key: value\n"},
+		"wrong kind":    {kind: SecretKind, content: configMapContent},
+		"missing name":  {kind: ConfigMapKind, content: "data:\n  key: value\n"},
+		"unknown kind":  {kind: Kind("Pod"), content: configMapContent},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Create(context.TODO(), fake.NewSimpleClientset(), newKarmadaClient(false), test.kind, "default", "",
+				test.content, nil, nil)
+			if !apierrors.IsBadRequest(err) {
+				t.Errorf("expected a bad request error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateFromFields(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := fake.NewSimpleClientset()
+	replicas := int32(2)
+
+	_, err := Create(ctx, k8sClient, newKarmadaClient(false), StatefulSetKind, "default", "web", "", &Fields{
+		Image:    "nginx:1.25",
+		Replicas: &replicas,
+		Ports:    []Port{{Name: "http", Port: 80}},
+		Env:      map[string]string{"B": "2", "A": "1"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	sts, err := k8sClient.AppsV1().StatefulSets("default").Get(ctx, "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the StatefulSet to be created, got %v", err)
+	}
+	if *sts.Spec.Replicas != 2 || sts.Spec.Selector.MatchLabels["app"] != "web" || sts.Spec.Template.Labels["app"] != "web" {
+		t.Errorf("unexpected replicas, selector or labels %+v", sts.Spec)
+	}
+	container := sts.Spec.Template.Spec.Containers[0]
+	if container.Name != "web" || container.Image != "nginx:1.25" || container.Ports[0].ContainerPort != 80 ||
+		container.Env[0].Name != "A" || container.Env[1].Name != "B" {
+		t.Errorf("unexpected container %+v", container)
+	}
+}
+
+func TestUpdateFromFields(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := fake.NewSimpleClientset()
+	karmadaClient := newKarmadaClient(false)
+	if _, err := Create(ctx, k8sClient, karmadaClient, ConfigMapKind, "default", "", configMapContent, nil, nil); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	_, err := Update(ctx, k8sClient, karmadaClient, ConfigMapKind, "default", "settings", "", &Fields{
+		Labels: map[string]string{"team": "web"},
+		Data:   map[string]string{"other": "value"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	configMap, err := k8sClient.CoreV1().ConfigMaps("default").Get(ctx, "settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configMap.Data) != 1 || configMap.Data["other"] != "value" || configMap.Labels["team"] != "web" {
+		t.Errorf("unexpected ConfigMap %+v", configMap)
+	}
+}
+
+func TestCreateValidatesFields(t *testing.T) {
+	tests := map[string]struct {
+		kind    Kind
+		content string
+		fields  *Fields
+	}{
+		"content and fields":    {kind: ConfigMapKind, content: configMapContent, fields: &Fields{}},
+		"neither":               {kind: ConfigMapKind},
+		"missing image":         {kind: DaemonSetKind, fields: &Fields{}},
+		"missing schedule":      {kind: CronJobKind, fields: &Fields{Image: "busybox"}},
+		"missing service ports": {kind: ServiceKind, fields: &Fields{}},
+		"incomplete rule":       {kind: IngressKind, fields: &Fields{Rules: []IngressRule{{Host: "example.com"}}}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Create(context.TODO(), fake.NewSimpleClientset(), newKarmadaClient(false), test.kind, "default", "settings",
+				test.content, test.fields, nil)
+			if !apierrors.IsBadRequest(err) {
+				t.Errorf("expected a bad request error, got %v", err)
+			}
+		})
+	}
+}
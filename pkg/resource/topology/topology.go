@@ -18,7 +18,10 @@ package topology
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
+	corev1 "k8s.io/api/core/v1"
 	kubeclient "k8s.io/client-go/kubernetes"
 )
 
@@ -29,3 +32,55 @@ func GetResourceTopology(
 	namespace, name, kind string) (*TopologyResponse, error) {
 	return traceChain(ctx, k8sClient, namespace, name, kind)
 }
+
+// ClusterPods are the pods of a workload in a single member cluster.
+type ClusterPods struct {
+	Cluster string
+	Pods    []*corev1.Pod
+}
+
+// GetWorkloadPods resolves the pods of a workload in every member cluster it is propagated to,
+// following the same ResourceBinding and Work chain as the topology. Clusters whose pods cannot be
+// listed are reported as non-critical errors.
+func GetWorkloadPods(
+	ctx context.Context,
+	k8sClient kubeclient.Interface,
+	namespace, name, kind string) ([]ClusterPods, []error, error) {
+	uid, _, err := getWorkload(ctx, k8sClient, namespace, name, kind)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get workload: %w", err)
+	}
+	rbs, err := getResourceBindings(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusters := make(map[string]bool)
+	for _, rb := range rbs {
+		works, err := getWorksByRBName(rb.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, w := range works {
+			if clusterName := clusterNameFromWorkNamespace(w.Namespace); clusterName != "" {
+				clusters[clusterName] = true
+			}
+		}
+	}
+	names := make([]string, 0, len(clusters))
+	for clusterName := range clusters {
+		names = append(names, clusterName)
+	}
+	sort.Strings(names)
+
+	result := make([]ClusterPods, 0, len(names))
+	nonCriticalErrors := make([]error, 0)
+	for _, clusterName := range names {
+		pods, err := getPodsByWorkUID(ctx, clusterName, namespace, name, kind)
+		if err != nil {
+			nonCriticalErrors = append(nonCriticalErrors, fmt.Errorf("cluster %s: %w", clusterName, err))
+			continue
+		}
+		result = append(result, ClusterPods{Cluster: clusterName, Pods: pods})
+	}
+	return result, nonCriticalErrors, nil
+}
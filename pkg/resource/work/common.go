@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/names"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// WorkCell is a wrapper around Work type
+type WorkCell workv1alpha1.Work
+
+// GetProperty returns the given property of the Work.
+func (c WorkCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty, dataselect.ExecutionNamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableStringSet{clusterName(c.ObjectMeta.Namespace)}
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableStringSet(common.ConditionStatuses(c.Status.Conditions))
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// clusterName returns the member cluster of an execution namespace, or "" for other namespaces.
+func clusterName(executionNamespace string) string {
+	cluster, err := names.GetClusterName(executionNamespace)
+	if err != nil {
+		return ""
+	}
+	return cluster
+}
+
+func toCells(std []workv1alpha1.Work) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = WorkCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []workv1alpha1.Work {
+	std := make([]workv1alpha1.Work, len(cells))
+	for i := range std {
+		std[i] = workv1alpha1.Work(cells[i].(WorkCell))
+	}
+	return std
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"context"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkDetail is a presentation layer view of Karmada Work resource.
+type WorkDetail struct {
+	// Extends list item structure.
+	Work `json:",inline"`
+
+	// Manifests are the resources applied to the member cluster, after overrides.
+	Manifests []workv1alpha1.Manifest `json:"manifests"`
+	// SuspendDispatching and PreserveResourcesOnDeletion reflect the Work spec.
+	SuspendDispatching          bool `json:"suspendDispatching"`
+	PreserveResourcesOnDeletion bool `json:"preserveResourcesOnDeletion"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetWorkDetail gets Work details.
+func GetWorkDetail(client karmadaclientset.Interface, namespace, name string) (*WorkDetail, error) {
+	work, err := client.WorkV1alpha1().Works(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &WorkDetail{
+		Work:      toWork(work),
+		Manifests: work.Spec.Workload.Manifests,
+		Errors:    []error{},
+	}
+	if work.Spec.SuspendDispatching != nil {
+		detail.SuspendDispatching = *work.Spec.SuspendDispatching
+	}
+	if work.Spec.PreserveResourcesOnDeletion != nil {
+		detail.PreserveResourcesOnDeletion = *work.Spec.PreserveResourcesOnDeletion
+	}
+	return detail, nil
+}
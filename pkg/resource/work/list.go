@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"context"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// WorkList contains a list of Works in the karmada control-plane.
+type WorkList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of Works.
+	Works []Work `json:"works"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// BindingReference identifies the ResourceBinding or ClusterResourceBinding a Work belongs to.
+type BindingReference struct {
+	Kind      types.ResourceKind `json:"kind"`
+	Namespace string             `json:"namespace,omitempty"`
+	Name      string             `json:"name"`
+}
+
+// Work contains information about a single Work.
+type Work struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Cluster is the member cluster the Work is applied to.
+	Cluster          string                        `json:"cluster"`
+	Binding          *BindingReference             `json:"binding,omitempty"`
+	ManifestCount    int                           `json:"manifestCount"`
+	Conditions       []metav1.Condition            `json:"conditions"`
+	ManifestStatuses []workv1alpha1.ManifestStatus `json:"manifestStatuses"`
+}
+
+// GetWorkList returns a list of all Works in the karmada control-plane.
+func GetWorkList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*WorkList, error) {
+	works, err := client.WorkV1alpha1().Works(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toWorkList(works.Items, nonCriticalErrors, dsQuery), nil
+}
+
+// GetBindingWorks returns the Works created for the binding with the given permanent ID label.
+func GetBindingWorks(ctx context.Context, client karmadaclientset.Interface, permanentIDLabel, permanentID string) ([]Work, error) {
+	result := make([]Work, 0)
+	if permanentID == "" {
+		return result, nil
+	}
+	works, err := client.WorkV1alpha1().Works(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{permanentIDLabel: permanentID}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range works.Items {
+		result = append(result, toWork(&works.Items[i]))
+	}
+	return result, nil
+}
+
+func toWorkList(works []workv1alpha1.Work, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *WorkList {
+	workList := &WorkList{
+		Works:    make([]Work, 0),
+		ListMeta: types.ListMeta{TotalItems: len(works)},
+	}
+	workCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(works), dsQuery)
+	works = fromCells(workCells)
+	workList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	workList.Errors = nonCriticalErrors
+
+	for i := range works {
+		workList.Works = append(workList.Works, toWork(&works[i]))
+	}
+	return workList
+}
+
+func toWork(work *workv1alpha1.Work) Work {
+	return Work{
+		ObjectMeta:       types.NewObjectMeta(work.ObjectMeta),
+		TypeMeta:         types.NewTypeMeta(types.ResourceKindWork),
+		Cluster:          clusterName(work.Namespace),
+		Binding:          bindingReference(work.Annotations),
+		ManifestCount:    len(work.Spec.Workload.Manifests),
+		Conditions:       work.Status.Conditions,
+		ManifestStatuses: work.Status.ManifestStatuses,
+	}
+}
+
+func bindingReference(annotations map[string]string) *BindingReference {
+	if name := annotations[workv1alpha2.ResourceBindingNameAnnotationKey]; name != "" {
+		return &BindingReference{
+			Kind:      types.ResourceKindResourceBinding,
+			Namespace: annotations[workv1alpha2.ResourceBindingNamespaceAnnotationKey],
+			Name:      name,
+		}
+	}
+	if name := annotations[workv1alpha2.ClusterResourceBindingAnnotationKey]; name != "" {
+		return &BindingReference{Kind: types.ResourceKindClusterResourceBinding, Name: name}
+	}
+	return nil
+}
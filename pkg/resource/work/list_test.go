@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+func TestBindingReference(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        *BindingReference
+	}{
+		{
+			name: "resource binding",
+			annotations: map[string]string{
+				workv1alpha2.ResourceBindingNamespaceAnnotationKey: "default",
+				workv1alpha2.ResourceBindingNameAnnotationKey:      "nginx-deployment",
+			},
+			want: &BindingReference{Kind: types.ResourceKindResourceBinding, Namespace: "default", Name: "nginx-deployment"},
+		},
+		{
+			name:        "cluster resource binding",
+			annotations: map[string]string{workv1alpha2.ClusterResourceBindingAnnotationKey: "admin-clusterrole"},
+			want:        &BindingReference{Kind: types.ResourceKindClusterResourceBinding, Name: "admin-clusterrole"},
+		},
+		{
+			name: "resource binding wins over cluster resource binding",
+			annotations: map[string]string{
+				workv1alpha2.ResourceBindingNamespaceAnnotationKey: "default",
+				workv1alpha2.ResourceBindingNameAnnotationKey:      "nginx-deployment",
+				workv1alpha2.ClusterResourceBindingAnnotationKey:   "admin-clusterrole",
+			},
+			want: &BindingReference{Kind: types.ResourceKindResourceBinding, Namespace: "default", Name: "nginx-deployment"},
+		},
+		{
+			name:        "empty binding name",
+			annotations: map[string]string{workv1alpha2.ResourceBindingNameAnnotationKey: ""},
+		},
+		{
+			name: "no annotations",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bindingReference(tt.annotations)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("bindingReference() = %+v, want nil", got)
+			case tt.want != nil && (got == nil || *got != *tt.want):
+				t.Errorf("bindingReference() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newBindingWork(namespace, name string, labels map[string]string) *workv1alpha1.Work {
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    labels,
+			Annotations: map[string]string{
+				workv1alpha2.ResourceBindingNamespaceAnnotationKey: "default",
+				workv1alpha2.ResourceBindingNameAnnotationKey:      "nginx-deployment",
+			},
+		},
+		Spec: workv1alpha1.WorkSpec{Workload: workv1alpha1.WorkloadTemplate{
+			Manifests: []workv1alpha1.Manifest{{}},
+		}},
+	}
+}
+
+func TestGetBindingWorks(t *testing.T) {
+	bindingLabels := map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "binding-id"}
+	objects := []runtime.Object{
+		newBindingWork("karmada-es-member1", "nginx-687f7fb96f", bindingLabels),
+		newBindingWork("karmada-es-member2", "nginx-687f7fb96f", bindingLabels),
+		newBindingWork("karmada-es-member1", "redis-5c8b7f9d4b",
+			map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "another-binding-id"}),
+		newBindingWork("karmada-es-member1", "admin-6d9c5f8b7c",
+			map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "binding-id"}),
+	}
+
+	tests := []struct {
+		name         string
+		label        string
+		permanentID  string
+		listErr      error
+		wantClusters []string
+		wantErr      bool
+	}{
+		{
+			name:         "works of the binding across clusters",
+			label:        workv1alpha2.ResourceBindingPermanentIDLabel,
+			permanentID:  "binding-id",
+			wantClusters: []string{"member1", "member2"},
+		},
+		{
+			name:         "permanent ID is matched under the given label only",
+			label:        workv1alpha2.ClusterResourceBindingPermanentIDLabel,
+			permanentID:  "binding-id",
+			wantClusters: []string{"member1"},
+		},
+		{
+			name:        "binding without works",
+			label:       workv1alpha2.ResourceBindingPermanentIDLabel,
+			permanentID: "unknown-id",
+		},
+		{
+			name:    "binding without permanent ID is not looked up",
+			label:   workv1alpha2.ResourceBindingPermanentIDLabel,
+			listErr: errors.New("must not list"),
+		},
+		{
+			name:        "list failure",
+			label:       workv1alpha2.ResourceBindingPermanentIDLabel,
+			permanentID: "binding-id",
+			listErr:     errors.New("etcd timeout"),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := karmadafake.NewSimpleClientset(objects...)
+			if tt.listErr != nil {
+				client.PrependReactor("list", "works", func(clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.listErr
+				})
+			}
+
+			works, err := GetBindingWorks(context.TODO(), client, tt.label, tt.permanentID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetBindingWorks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if works == nil || len(works) != len(tt.wantClusters) {
+				t.Fatalf("GetBindingWorks() = %+v, want works on %v", works, tt.wantClusters)
+			}
+			clusters := make(map[string]bool, len(works))
+			for _, w := range works {
+				clusters[w.Cluster] = true
+				if w.Binding == nil || w.Binding.Name != "nginx-deployment" || w.ManifestCount != 1 {
+					t.Errorf("unexpected Work %s/%s: %+v", w.ObjectMeta.Namespace, w.ObjectMeta.Name, w)
+				}
+			}
+			for _, cluster := range tt.wantClusters {
+				if !clusters[cluster] {
+					t.Errorf("expected a Work on %s, got %+v", cluster, works)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package templaterevision keeps the pod template history of workload resource templates. The
+// Karmada control plane runs no workload controllers, so there are no ReplicaSets to roll back
+// to; revisions are recorded whenever the dashboard changes a template, including raw object edits.
+// Changes made outside the dashboard, e.g. with kubectl, are not recorded.
+package templaterevision
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/configmapstore"
+)
+
+const (
+	// maxRevisions bounds the number of revisions kept per workload.
+	maxRevisions = 10
+
+	// ManagedByLabel marks ConfigMaps that hold template revisions.
+	ManagedByLabel = "dashboard.karmada.io/template-revisions"
+	// WorkloadKindLabel records the kind of the workload the revisions belong to.
+	WorkloadKindLabel = "dashboard.karmada.io/workload-kind"
+	// WorkloadNamespaceAnnotation records the namespace of the workload the revisions belong to.
+	WorkloadNamespaceAnnotation = "dashboard.karmada.io/workload-namespace"
+	// WorkloadNameAnnotation records the name of the workload the revisions belong to.
+	WorkloadNameAnnotation = "dashboard.karmada.io/workload-name"
+)
+
+// WorkloadRef identifies a workload resource template, e.g. a Deployment.
+type WorkloadRef struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+func (ref WorkloadRef) key() string {
+	return fmt.Sprintf("%s/%s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
+
+// Revision is a snapshot of a pod template saved when the workload was changed through the dashboard.
+type Revision struct {
+	// Revision is the sequence number of the revision, starting from 1.
+	Revision int64 `json:"revision"`
+	// Author is the user who made the change, empty if unknown.
+	Author string `json:"author"`
+	// Timestamp is the time the revision was recorded.
+	Timestamp metav1.Time `json:"timestamp"`
+	// Template is the full pod template at this revision.
+	Template corev1.PodTemplateSpec `json:"template"`
+}
+
+// RevisionNumber implements configmapstore.Revision.
+func (r Revision) RevisionNumber() int64 {
+	return r.Revision
+}
+
+// RevisionList contains the revisions of a single workload, newest first.
+type RevisionList struct {
+	Workload  WorkloadRef `json:"workload"`
+	Revisions []Revision  `json:"revisions"`
+}
+
+var store = configmapstore.NewRevisionStore[Revision](
+	configmapstore.New("template revision", "karmada-dashboard-template-revisions-", ManagedByLabel), maxRevisions)
+
+// Init sets the client and namespace used to persist template revisions.
+func Init(k8sClient kubernetes.Interface, namespace string) {
+	store.Init(k8sClient, namespace)
+}
+
+// ListRevisions returns all stored revisions of the workload, newest first. Template changes made
+// outside the dashboard are missing, the next dashboard change records the template they left.
+func ListRevisions(ctx context.Context, ref WorkloadRef) (*RevisionList, error) {
+	revisions, err := store.List(ctx, ref.key())
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionList{Workload: ref, Revisions: revisions}, nil
+}
+
+// GetRevision returns a single revision of the workload.
+func GetRevision(ctx context.Context, ref WorkloadRef, revision int64) (*Revision, error) {
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Revisions {
+		if list.Revisions[i].Revision == revision {
+			return &list.Revisions[i], nil
+		}
+	}
+	return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), fmt.Sprintf("%s %s revision %d", ref.Kind, ref.Name, revision))
+}
+
+// RecordRevision saves template as a new revision of the workload. Nothing is recorded when
+// template equals the latest revision. The returned revision is the latest one after the call.
+func RecordRevision(ctx context.Context, ref WorkloadRef, author string, template corev1.PodTemplateSpec) (*Revision, error) {
+	recorded, err := store.Record(ctx, ref.key(),
+		map[string]string{WorkloadKindLabel: ref.Kind},
+		map[string]string{WorkloadNamespaceAnnotation: ref.Namespace, WorkloadNameAnnotation: ref.Name},
+		func(latest Revision) bool {
+			return equality.Semantic.DeepEqual(latest.Template, template)
+		},
+		func(number int64) Revision {
+			return Revision{Revision: number, Author: author, Timestamp: metav1.NewTime(time.Now()), Template: template}
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &recorded, nil
+}
+
+// EnsureBaseline records template as the first revision when the workload has no history yet, so
+// that the state before the first dashboard edit can be rolled back to.
+func EnsureBaseline(ctx context.Context, ref WorkloadRef, template corev1.PodTemplateSpec) error {
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if len(list.Revisions) > 0 {
+		return nil
+	}
+	_, err = RecordRevision(ctx, ref, "", template)
+	return err
+}
+
+// WorkloadTemplate returns the ref and pod template of obj when it is a workload whose revisions are
+// recorded, which are Deployments only.
+func WorkloadTemplate(obj *unstructured.Unstructured) (WorkloadRef, corev1.PodTemplateSpec, bool) {
+	if obj.GroupVersionKind().GroupKind() != appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind() {
+		return WorkloadRef{}, corev1.PodTemplateSpec{}, false
+	}
+	deployment := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+		return WorkloadRef{}, corev1.PodTemplateSpec{}, false
+	}
+	ref := WorkloadRef{Kind: "Deployment", Namespace: deployment.Namespace, Name: deployment.Name}
+	return ref, deployment.Spec.Template, true
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templaterevision
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func templateWithImage(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+	}
+}
+
+func TestRecordRevision(t *testing.T) {
+	Init(fake.NewSimpleClientset(), "karmada-dashboard")
+	ctx := context.TODO()
+	ref := WorkloadRef{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	if err := EnsureBaseline(ctx, ref, templateWithImage("nginx:1.0")); err != nil {
+		t.Fatalf("EnsureBaseline() error = %v", err)
+	}
+	// an existing history must not get another baseline
+	if err := EnsureBaseline(ctx, ref, templateWithImage("nginx:0.9")); err != nil {
+		t.Fatalf("EnsureBaseline() error = %v", err)
+	}
+	for i := 0; i < maxRevisions+2; i++ {
+		image := "nginx:1.0"
+		if i%2 == 0 {
+			image = "nginx:1.1"
+		}
+		if _, err := RecordRevision(ctx, ref, "alice", templateWithImage(image)); err != nil {
+			t.Fatalf("RecordRevision() error = %v", err)
+		}
+	}
+
+	list, err := ListRevisions(ctx, ref)
+	if err != nil {
+		t.Fatalf("ListRevisions() error = %v", err)
+	}
+	if len(list.Revisions) != maxRevisions {
+		t.Fatalf("ListRevisions() returned %d revisions, expected %d", len(list.Revisions), maxRevisions)
+	}
+	if latest := list.Revisions[0]; latest.Revision != maxRevisions+3 || latest.Template.Spec.Containers[0].Image != "nginx:1.0" {
+		t.Errorf("unexpected latest revision %d with image %s", latest.Revision, latest.Template.Spec.Containers[0].Image)
+	}
+	if _, err = GetRevision(ctx, ref, 1); err == nil {
+		t.Errorf("expected the baseline revision to be pruned")
+	}
+}
+
+func TestWorkloadTemplate(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "nginx"},
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "app", "image": "nginx:1.0"}},
+		}}},
+	}}
+	ref, template, ok := WorkloadTemplate(deployment)
+	if !ok {
+		t.Fatalf("WorkloadTemplate() found no template in a Deployment")
+	}
+	if want := (WorkloadRef{Kind: "Deployment", Namespace: "default", Name: "nginx"}); ref != want {
+		t.Errorf("WorkloadTemplate() ref = %+v, expected %+v", ref, want)
+	}
+	if !equality.Semantic.DeepEqual(template, templateWithImage("nginx:1.0")) {
+		t.Errorf("WorkloadTemplate() template = %+v", template)
+	}
+
+	statefulSet := deployment.DeepCopy()
+	statefulSet.SetKind("StatefulSet")
+	if _, _, ok = WorkloadTemplate(statefulSet); ok {
+		t.Errorf("expected no revisions for a StatefulSet")
+	}
+}